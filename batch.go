@@ -0,0 +1,263 @@
+package passgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Number of duplicate secrets in a row after which a unique batch gives up with ErrTooManyDuplicates
+const maxDuplicates = 1000
+
+// BatchOption configures how GenerateN and Stream generate a batch of secrets
+type BatchOption interface {
+	applyBatch(c *batchConfig) error
+}
+
+type batchOption func(c *batchConfig) error
+
+func (o batchOption) applyBatch(c *batchConfig) error {
+	return o(c)
+}
+
+// Settings collected from BatchOptions
+type batchConfig struct {
+	workers int
+	set     UniqueSet
+}
+
+// Generate secrets in n goroutines at once. The generator must be safe for concurrent use, as the generators in this package are.
+// Secrets are generated one at a time, in order, unless more than one worker is given
+func WithWorkers(n int) BatchOption {
+	return batchOption(func(c *batchConfig) error {
+		if n < 1 {
+			return fmt.Errorf("%w: number of workers must be positive", ErrInvalidLength)
+		}
+		c.workers = n
+		return nil
+	})
+}
+
+// Never include the same secret twice in a batch, keeping track of the secrets in a new MemorySet. Duplicates are wiped and generated again,
+// until so many duplicates are generated in a row that the batch fails with ErrTooManyDuplicates.
+// A batch asking for more than half of the secrets the generator can create, based on CollisionBits, fails straight away with ErrSpaceTooSmall;
+// use CollisionProbability to see how likely duplicates would be without this option
+func WithUnique() BatchOption {
+	return batchOption(func(c *batchConfig) error {
+		c.set = NewMemorySet()
+		return nil
+	})
+}
+
+// Like WithUnique, but keep track of the secrets in the given set, such as a BloomSet for batches too large to remember every secret.
+// Secrets already in the set are treated as duplicates, so a set can be reused to keep several batches unique from each other
+func WithUniqueSet(set UniqueSet) BatchOption {
+	return batchOption(func(c *batchConfig) error {
+		if set == nil {
+			return errors.New("Unique set must not be nil")
+		}
+		c.set = set
+		return nil
+	})
+}
+
+// BatchResult is a secret from a batch generated by Stream, or the error that ended the batch
+type BatchResult struct {
+	Secret Secret
+	Err    error
+}
+
+// GenerateN generates a batch of n secrets from gen.
+// If any secret can't be generated, or ctx is done first, the secrets generated so far are wiped and the error is returned
+func GenerateN(ctx context.Context, gen Generator, n int, opts ...BatchOption) ([]Secret, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("%w: number of secrets must not be negative", ErrInvalidLength)
+	}
+	secrets := make([]Secret, 0, n)
+	var err error
+	for r := range Stream(ctx, gen, n, opts...) {
+		if r.Err != nil {
+			err = r.Err
+			break
+		}
+		secrets = append(secrets, r.Secret)
+	}
+	if err == nil && len(secrets) < n {
+		err = ctx.Err()
+	}
+	if err != nil {
+		for _, s := range secrets {
+			s.Wipe()
+		}
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// Stream generates a batch of n secrets from gen, sending each one on the returned channel as it is generated,
+// so large batches don't have to be held in memory. The channel is closed once all n secrets have been sent.
+// If a secret can't be generated, the error is sent as the last result before the channel is closed.
+// Cancel ctx to stop generating secrets before all of them have been received; the channel is then closed early, and ctx.Err() reports why
+func Stream(ctx context.Context, gen Generator, n int, opts ...BatchOption) <-chan BatchResult {
+	out := make(chan BatchResult, 64)
+	c := batchConfig{workers: 1}
+	var err error
+	for _, o := range opts {
+		if err = o.applyBatch(&c); err != nil {
+			break
+		}
+	}
+	if err == nil && n < 0 {
+		err = fmt.Errorf("%w: number of secrets must not be negative", ErrInvalidLength)
+	}
+	if err == nil && c.set != nil {
+		// Fail before generating anything if the batch can't reasonably be unique
+		var bits float64
+		if bits, err = CollisionBits(gen); err == nil {
+			err = checkUniqueSpace(bits, n)
+		}
+	}
+	if err != nil {
+		out <- BatchResult{Err: err}
+		close(out)
+		return out
+	}
+	go c.run(ctx, gen, n, out)
+	return out
+}
+
+// Generate the batch, then close out
+func (c *batchConfig) run(ctx context.Context, gen Generator, n int, out chan<- BatchResult) {
+	defer close(out)
+	if n == 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Workers generate secrets in chunks until the batch is done, wiping any that are left over.
+	// Chunks save a channel send for every secret, which matters for batches of millions of short codes
+	chunkSize := (n + c.workers - 1) / c.workers
+	if chunkSize > 64 {
+		chunkSize = 64
+	}
+	generated := make(chan []BatchResult, c.workers)
+	var wg sync.WaitGroup
+	for i := 0; i < c.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				chunk := make([]BatchResult, 0, chunkSize)
+				for len(chunk) < chunkSize {
+					s, err := gen.Generate(ctx)
+					chunk = append(chunk, BatchResult{Secret: s, Err: err})
+					if err != nil {
+						break
+					}
+				}
+				select {
+				case generated <- chunk:
+				case <-ctx.Done():
+					wipeResults(chunk)
+					return
+				}
+				if chunk[len(chunk)-1].Err != nil {
+					return
+				}
+			}
+		}()
+	}
+	defer func() {
+		cancel()
+		go func() {
+			wg.Wait()
+			close(generated)
+		}()
+		for chunk := range generated {
+			wipeResults(chunk)
+		}
+	}()
+
+	duplicates := 0
+	sent := 0
+	for sent < n {
+		var chunk []BatchResult
+		select {
+		case chunk = <-generated:
+		case <-ctx.Done():
+			return
+		}
+		for i, r := range chunk {
+			if sent == n {
+				wipeResults(chunk[i:])
+				break
+			}
+			if r.Err != nil {
+				c.fail(ctx, out, r.Err)
+				return
+			}
+			if c.set != nil {
+				added, err := c.set.Add(r.Secret.Bytes())
+				if err != nil {
+					wipeResults(chunk[i:])
+					c.fail(ctx, out, err)
+					return
+				}
+				if !added {
+					r.Secret.Wipe()
+					if duplicates++; duplicates >= maxDuplicates {
+						wipeResults(chunk[i+1:])
+						c.fail(ctx, out, fmt.Errorf("%w: %d in a row after %d unique secrets", ErrTooManyDuplicates, duplicates, sent))
+						return
+					}
+					continue
+				}
+				duplicates = 0
+			}
+			select {
+			case out <- r:
+				sent++
+			case <-ctx.Done():
+				wipeResults(chunk[i:])
+				return
+			}
+		}
+	}
+}
+
+// Wipe the secrets in a chunk that weren't sent
+func wipeResults(chunk []BatchResult) {
+	for _, r := range chunk {
+		r.Secret.Wipe()
+	}
+}
+
+// Send the error that ended a batch, unless the receiver has already given up on it
+func (c *batchConfig) fail(ctx context.Context, out chan<- BatchResult, err error) {
+	select {
+	case out <- BatchResult{Err: err}:
+	case <-ctx.Done():
+	}
+}
+
+// GenerateN generates a batch of n passwords with the lengths set by WithLength or SetLength. See the package function GenerateN
+func (p *PasswordGenerator) GenerateN(ctx context.Context, n int, opts ...BatchOption) ([]Secret, error) {
+	return GenerateN(ctx, p, n, opts...)
+}
+
+// Stream generates a batch of n passwords with the lengths set by WithLength or SetLength. See the package function Stream
+func (p *PasswordGenerator) Stream(ctx context.Context, n int, opts ...BatchOption) <-chan BatchResult {
+	return Stream(ctx, p, n, opts...)
+}
+
+// GenerateN generates a batch of n passphrases with the number of words set by WithWordCount or SetWordCount. See the package function GenerateN
+func (p *PassphraseGenerator) GenerateN(ctx context.Context, n int, opts ...BatchOption) ([]Secret, error) {
+	return GenerateN(ctx, p, n, opts...)
+}
+
+// Stream generates a batch of n passphrases with the number of words set by WithWordCount or SetWordCount. See the package function Stream
+func (p *PassphraseGenerator) Stream(ctx context.Context, n int, opts ...BatchOption) <-chan BatchResult {
+	return Stream(ctx, p, n, opts...)
+}
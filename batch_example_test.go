@@ -0,0 +1,38 @@
+package passgen
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+func ExampleGenerateN() {
+	// Generate 1000 different 8 digit codes, using 4 goroutines
+	gen, err := NewPassword(WithCharset(Digits), WithLength(8, 8))
+	if err != nil {
+		// Handle error
+	}
+	codes, err := GenerateN(context.Background(), gen, 1000, WithWorkers(4), WithUnique())
+	if err != nil {
+		// Handle error
+	}
+	fmt.Println(len(codes))
+	// Output: 1000
+}
+
+func ExampleStream() {
+	// Write a million passwords to a file without holding them all in memory
+	file, err := os.Create("passwords.txt")
+	if err != nil {
+		// Handle error
+	}
+	defer file.Close()
+	for r := range GetSecurePasswordGenerator().Stream(context.Background(), 1000000) {
+		if r.Err != nil {
+			// Handle error
+		}
+		file.Write(r.Secret.Bytes())
+		file.Write([]byte("\n"))
+		r.Secret.Wipe()
+	}
+}
@@ -0,0 +1,181 @@
+package passgen
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	mathrand "math/rand"
+	"strings"
+	"testing"
+)
+
+func TestGenerateN(t *testing.T) {
+	gen, err := NewPassword(WithCharset(Digits), WithLength(6, 6))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	phrase, err := NewPassphrase(WithDictionaryWords([]string{"apple", "banana", "cherry", "kiwi"}), WithWordCount(3))
+	if err != nil {
+		t.Fatal("Error creating passphrase generator", err)
+	}
+
+	for _, opts := range [][]BatchOption{nil, {WithWorkers(4)}, {WithUnique()}, {WithWorkers(3), WithUnique()}} {
+		secrets, err := gen.GenerateN(context.Background(), 500, opts...)
+		if err != nil {
+			t.Fatal("Error generating passwords", err)
+		}
+		if len(secrets) != 500 {
+			t.Fatalf("Generated %d passwords, expected 500", len(secrets))
+		}
+		for _, s := range secrets {
+			if s.Len() != 6 || strings.Trim(s.Value(), Digits.String()) != "" {
+				t.Errorf("Incorrect password: %q", s.Value())
+			}
+		}
+
+		phrases, err := phrase.GenerateN(context.Background(), 10, opts...)
+		if err != nil {
+			t.Fatal("Error generating passphrases", err)
+		}
+		for _, s := range phrases {
+			if len(strings.Fields(s.Value())) != 3 {
+				t.Errorf("Incorrect passphrase: %q", s.Value())
+			}
+		}
+	}
+
+	secrets, err := GenerateN(context.Background(), gen, 0)
+	if err != nil || len(secrets) != 0 {
+		t.Errorf("GenerateN of 0 secrets returned %d secrets, %v", len(secrets), err)
+	}
+	if _, err := GenerateN(context.Background(), gen, -1); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength for a negative count, got %v", err)
+	}
+	if _, err := GenerateN(context.Background(), gen, 1, WithWorkers(0)); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength for 0 workers, got %v", err)
+	}
+}
+
+// With one worker, a batch is the same as generating each secret in turn
+func TestGenerateNOrder(t *testing.T) {
+	newGen := func() *PasswordGenerator {
+		gen, err := NewPassword(WithEntropySource(InsecureEntropySource(mathrand.New(mathrand.NewSource(7)))))
+		if err != nil {
+			t.Fatal("Error creating password generator", err)
+		}
+		return gen
+	}
+	secrets, err := newGen().GenerateN(context.Background(), 200)
+	if err != nil {
+		t.Fatal("Error generating passwords", err)
+	}
+	gen := newGen()
+	for i, s := range secrets {
+		want, err := gen.Generate(context.Background())
+		if err != nil {
+			t.Fatal("Error generating password", err)
+		}
+		if !bytes.Equal(s.Bytes(), want.Bytes()) {
+			t.Fatalf("Password %d of the batch is %q, expected %q", i, s.Value(), want.Value())
+		}
+	}
+}
+
+func TestGenerateNUnique(t *testing.T) {
+	// Only 100 passwords are possible
+	gen, err := NewPassword(WithCharset(Digits), WithLength(2, 2))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	secrets, err := gen.GenerateN(context.Background(), 50, WithUnique(), WithWorkers(2))
+	if err != nil {
+		t.Fatal("Error generating unique passwords", err)
+	}
+	seen := make(map[string]bool)
+	for _, s := range secrets {
+		if seen[s.Value()] {
+			t.Errorf("Password %q generated twice", s.Value())
+		}
+		seen[s.Value()] = true
+	}
+
+	// Asking for more than half of the possible passwords fails before any are generated
+	if _, err := gen.GenerateN(context.Background(), 51, WithUnique()); !errors.Is(err, ErrSpaceTooSmall) {
+		t.Errorf("Expected ErrSpaceTooSmall when asking for more than half of the possible passwords, got %v", err)
+	}
+
+	// A generator that claims more entropy than it has is caught by the duplicates it keeps generating
+	if _, err := GenerateN(context.Background(), constantGenerator{}, 2, WithUnique()); !errors.Is(err, ErrTooManyDuplicates) {
+		t.Errorf("Expected ErrTooManyDuplicates from a generator that always generates the same secret, got %v", err)
+	}
+}
+
+// A Generator that always generates the same secret, while claiming it has plenty of entropy
+type constantGenerator struct{}
+
+func (constantGenerator) Generate(ctx context.Context) (Secret, error) {
+	return NewSecret([]byte("secret")), ctx.Err()
+}
+
+func (constantGenerator) Bits() (float64, error) {
+	return 64, nil
+}
+
+func (constantGenerator) Describe() string {
+	return "constant"
+}
+
+func TestGenerateNErrors(t *testing.T) {
+	// Enough random data for a few passwords, then the entropy source fails
+	gen, err := NewPassword(WithCharset(Digits), WithLength(8, 8), WithEntropySource(InsecureEntropySource(bytes.NewReader(make([]byte, 40)))))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if _, err := gen.GenerateN(context.Background(), 100); !errors.Is(err, ErrEntropySource) {
+		t.Errorf("Expected ErrEntropySource, got %v", err)
+	}
+
+	var results []BatchResult
+	for r := range gen.Stream(context.Background(), 100) {
+		results = append(results, r)
+	}
+	if len(results) == 0 || results[len(results)-1].Err == nil {
+		t.Error("Stream should end with the error that stopped the batch")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := GetSecurePasswordGenerator().GenerateN(ctx, 100); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestStreamCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := 0
+	for r := range GetSecurePasswordGenerator().Stream(ctx, 1000000, WithWorkers(4)) {
+		if r.Err != nil {
+			t.Fatal("Error generating password", r.Err)
+		}
+		r.Secret.Wipe()
+		// Stop early; the channel must still be closed
+		if received++; received == 10 {
+			cancel()
+		}
+	}
+	if received >= 1000000 {
+		t.Error("Stream should stop once the context is cancelled")
+	}
+}
+
+func BenchmarkStream(b *testing.B) {
+	gen, err := NewPassword(WithCharset(Digits), WithLength(8, 8))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for r := range gen.Stream(context.Background(), b.N) {
+		r.Secret.Wipe()
+	}
+}
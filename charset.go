@@ -0,0 +1,120 @@
+package passgen
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Commonly used Charsets that can be combined to build password alphabets
+var (
+	// Digits is the set of numeric characters (0-9) - 10 characters
+	Digits = CharRange('0', '9')
+	// Upper is the set of upper case letters (A-Z) - 26 characters
+	Upper = CharRange('A', 'Z')
+	// Lower is the set of lower case letters (a-z) - 26 characters
+	Lower = CharRange('a', 'z')
+	// Letters is the set of upper and lower case letters (A-Za-z) - 52 characters
+	Letters = Upper.Union(Lower)
+	// AlphaNumeric is the set of letters and digits (A-Za-z0-9) - 62 characters
+	AlphaNumeric = Letters.Union(Digits)
+	// Printable is the set of printable ASCII characters, including space (x20-x7E) - 95 characters
+	Printable = CharRange(' ', '~')
+	// Symbols is the set of printable ASCII characters that are not letters or digits, including space - 33 characters
+	Symbols = Printable.Subtract(AlphaNumeric)
+	// Ambiguous is the set of characters that are easily mistaken for one another when read aloud or retyped,
+	// such as 0/O, 1/l/I, 5/S and quote marks
+	Ambiguous = NewCharset("0Oo1lI|5S2Z8B`'\"")
+)
+
+// Charset is a set of characters that passwords can be generated from.
+// A Charset is kept sorted and free of duplicates, so every character in it is equally likely to be chosen.
+// Charsets are immutable; the methods that combine them return new Charsets.
+type Charset struct {
+	chars []rune
+}
+
+// Create a Charset made up of every character in the given string
+func NewCharset(chars string) Charset {
+	return newCharset([]rune(chars))
+}
+
+// Create a Charset of every character between first and last, inclusive
+func CharRange(first, last rune) Charset {
+	if last < first {
+		return Charset{}
+	}
+	chars := make([]rune, 0, last-first+1)
+	for r := first; r <= last; r++ {
+		chars = append(chars, r)
+	}
+	return Charset{chars: chars}
+}
+
+// Sort and deduplicate the given characters into a Charset
+func newCharset(chars []rune) Charset {
+	sorted := make([]rune, len(chars))
+	copy(sorted, chars)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	c := Charset{}
+	for i, r := range sorted {
+		if i > 0 && r == sorted[i-1] {
+			continue
+		}
+		c.chars = append(c.chars, r)
+	}
+	return c
+}
+
+// Union returns a Charset containing every character in c or in any of the others
+func (c Charset) Union(others ...Charset) Charset {
+	chars := append([]rune{}, c.chars...)
+	for _, o := range others {
+		chars = append(chars, o.chars...)
+	}
+	return newCharset(chars)
+}
+
+// Subtract returns a Charset containing the characters of c that are not in any of the others
+func (c Charset) Subtract(others ...Charset) Charset {
+	var chars []rune
+	for _, r := range c.chars {
+		found := false
+		for _, o := range others {
+			if o.Contains(r) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			chars = append(chars, r)
+		}
+	}
+	return Charset{chars: chars}
+}
+
+// Contains reports whether r is a member of the Charset
+func (c Charset) Contains(r rune) bool {
+	i := sort.Search(len(c.chars), func(i int) bool { return c.chars[i] >= r })
+	return i < len(c.chars) && c.chars[i] == r
+}
+
+// Len returns the number of characters in the Charset
+func (c Charset) Len() int {
+	return len(c.chars)
+}
+
+// Runes returns a copy of the characters in the Charset, in sorted order
+func (c Charset) Runes() []rune {
+	return append([]rune{}, c.chars...)
+}
+
+// String returns the characters in the Charset, in sorted order
+func (c Charset) String() string {
+	return string(c.chars)
+}
+
+// Report whether every character in the Charset can be represented by a single byte of ASCII
+func (c Charset) isASCII() bool {
+	return len(c.chars) == 0 || c.chars[len(c.chars)-1] < utf8.RuneSelf
+}
@@ -0,0 +1,61 @@
+package passgen
+
+import (
+	"testing"
+)
+
+func TestCharRange(t *testing.T) {
+	c := CharRange('a', 'e')
+	if c.String() != "abcde" {
+		t.Errorf("Incorrect charset. Expected: %q\t Actual: %q", "abcde", c.String())
+	}
+	if CharRange('e', 'a').Len() != 0 {
+		t.Error("A reversed range should be empty")
+	}
+}
+
+func TestNewCharset(t *testing.T) {
+	c := NewCharset("cabbac")
+	if c.String() != "abc" {
+		t.Errorf("Charset was not sorted and deduplicated. Expected: %q\t Actual: %q", "abc", c.String())
+	}
+}
+
+func TestCharsetUnion(t *testing.T) {
+	c := Digits.Union(NewCharset("!@#$%"), NewCharset("0x"))
+	expected := "!#$%0123456789@x"
+	if c.String() != expected {
+		t.Errorf("Incorrect union. Expected: %q\t Actual: %q", expected, c.String())
+	}
+	if AlphaNumeric.Len() != 62 {
+		t.Errorf("Incorrect sized charset. Expected: %d\t Actual: %d", 62, AlphaNumeric.Len())
+	}
+}
+
+func TestCharsetSubtract(t *testing.T) {
+	c := Digits.Subtract(NewCharset("13579"), NewCharset("0"))
+	if c.String() != "2468" {
+		t.Errorf("Incorrect subtraction. Expected: %q\t Actual: %q", "2468", c.String())
+	}
+	if Symbols.Len() != len(symbols) {
+		t.Errorf("Incorrect sized charset. Expected: %d\t Actual: %d", len(symbols), Symbols.Len())
+	}
+	for _, c := range symbols {
+		if !Symbols.Contains(c) {
+			t.Errorf("Symbol missing from charset: %c", c)
+		}
+	}
+}
+
+func TestCharsetContains(t *testing.T) {
+	if !Letters.Contains('q') || !Letters.Contains('Q') {
+		t.Error("Letter missing from charset")
+	}
+	if Letters.Contains('1') {
+		t.Error("Charset contains unexpected character")
+	}
+	var empty Charset
+	if empty.Contains('a') {
+		t.Error("Empty charset contains a character")
+	}
+}
@@ -0,0 +1,200 @@
+package passgen
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// DicewareList is a numbered word list, such as the original Diceware list or the EFF lists,
+// where each word is chosen by rolling a fixed number of six-sided dice.
+// Lines look like "11111	abacus": the dice rolls, whitespace, then the word.
+type DicewareList struct {
+	// Number of dice rolled to choose each word
+	Dice int
+
+	// Words indexed by their roll, read as a base 6 number
+	words []string
+}
+
+// Load a Diceware list from a file
+func LoadDicewareList(path string) (*DicewareList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, &DictionaryError{Path: path, Err: err}
+	}
+	defer file.Close()
+	return ParseDicewareList(file, path)
+}
+
+// Parse a Diceware list. The name is used to identify the list in errors.
+// Lines that don't start with a number, such as the PGP signature around the original Diceware list, are skipped.
+// Every roll must appear exactly once so that each word is equally likely.
+// Gzip and zstd compressed lists are decompressed automatically
+func ParseDicewareList(r io.Reader, name string) (*DicewareList, error) {
+	r, err := decompress(r)
+	if err != nil {
+		return nil, &DictionaryError{Path: name, Err: err}
+	}
+	if c, ok := r.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	l := &DicewareList{}
+	var found []bool
+	scanner := bufio.NewScanner(r)
+	n := 0
+	for scanner.Scan() {
+		n++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || !isNumber(fields[0]) {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, &DictionaryError{Path: name, Line: n, Err: fmt.Errorf("%w: entry must be a roll and a single word", ErrInvalidDicewareList)}
+		}
+		if l.Dice == 0 {
+			l.Dice = len(fields[0])
+			if l.Dice > 10 {
+				return nil, &DictionaryError{Path: name, Line: n, Err: fmt.Errorf("%w: too many dice", ErrInvalidDicewareList)}
+			}
+			l.words = make([]string, pow6(l.Dice))
+			found = make([]bool, len(l.words))
+		}
+		i, err := l.index(fields[0])
+		if err != nil {
+			return nil, &DictionaryError{Path: name, Line: n, Err: fmt.Errorf("%w: %v", ErrInvalidDicewareList, err)}
+		}
+		if found[i] {
+			return nil, &DictionaryError{Path: name, Line: n, Err: fmt.Errorf("%w: roll %s appears more than once", ErrInvalidDicewareList, fields[0])}
+		}
+		found[i] = true
+		l.words[i] = fields[1]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, &DictionaryError{Path: name, Line: n + 1, Err: err}
+	}
+
+	if l.Dice == 0 {
+		return nil, &DictionaryError{Path: name, Err: fmt.Errorf("%w: no numbered entries", ErrInvalidDicewareList)}
+	}
+	for i, ok := range found {
+		if !ok {
+			return nil, &DictionaryError{Path: name, Err: fmt.Errorf("%w: missing roll %s", ErrInvalidDicewareList, l.roll(i))}
+		}
+	}
+	return l, nil
+}
+
+// Get the Diceware list for a built-in word list
+func (l WordList) dicewareList() (*DicewareList, error) {
+	if l.Dice == 0 {
+		return nil, &DictionaryError{Path: l.Name, Err: fmt.Errorf("%w: list is not numbered", ErrInvalidDicewareList)}
+	}
+	words, err := l.words()
+	if err != nil {
+		return nil, err
+	}
+	return &DicewareList{Dice: l.Dice, words: words}, nil
+}
+
+// Len returns the number of words in the list
+func (l *DicewareList) Len() int {
+	return len(l.words)
+}
+
+// Words returns a copy of the words in the list, in roll order
+func (l *DicewareList) Words() []string {
+	return append([]string{}, l.words...)
+}
+
+// Word returns the word for a roll of the dice, such as "11111" or "1 1 1 1 1".
+// Returns an error matching ErrInvalidRoll if the roll isn't the right number of dice from 1 to 6
+func (l *DicewareList) Word(roll string) (string, error) {
+	i, err := l.index(strings.Join(strings.Fields(roll), ""))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidRoll, err)
+	}
+	return l.words[i], nil
+}
+
+// Get the index of a roll in the list
+func (l *DicewareList) index(roll string) (int, error) {
+	if len(roll) != l.Dice {
+		return 0, fmt.Errorf("roll %q must be %d dice", roll, l.Dice)
+	}
+	i := 0
+	for _, d := range roll {
+		if d < '1' || d > '6' {
+			return 0, fmt.Errorf("roll %q must only contain dice from 1 to 6", roll)
+		}
+		i = i*6 + int(d-'1')
+	}
+	return i, nil
+}
+
+// Get the roll for an index in the list
+func (l *DicewareList) roll(i int) string {
+	roll := make([]byte, l.Dice)
+	for d := l.Dice - 1; d >= 0; d-- {
+		roll[d] = '1' + byte(i%6)
+		i /= 6
+	}
+	return string(roll)
+}
+
+// Create a new Passphrase Generator from a numbered Diceware list file. The built-in Diceware lists, such as "eff-large", are chosen with NewPassphrase and WithWordList.
+// Every word in the list is kept, since filtering words by length would stop the rolls lining up with the words,
+// so MinWordLength and MaxWordLength are set to allow words of any length
+func NewDicewarePassphraseGenerator(dictFile string) (*PassphraseGenerator, error) {
+	return NewPassphrase(WithDiceware(dictFile))
+}
+
+// DicewareList returns the Diceware list the generator was created from, or nil if it wasn't created from one
+func (p *PassphraseGenerator) DicewareList() *DicewareList {
+	return p.diceware
+}
+
+// Generate a Passphrase from physical dice rolls, one roll for each word, for when the passphrase must not depend on a computer's random number generator.
+// Any random digits, symbols or separators in the generator's Format still come from its entropy source.
+// Returns ErrNoDicewareList if the generator wasn't created from a Diceware list
+func (p *PassphraseGenerator) PassphraseFromRolls(rolls []string) (string, error) {
+	if p.diceware == nil {
+		return "", ErrNoDicewareList
+	}
+	words := make([]string, len(rolls))
+	for i, roll := range rolls {
+		w, err := p.diceware.Word(roll)
+		if err != nil {
+			return "", err
+		}
+		words[i] = w
+	}
+	b, err := p.Format.apply(words, entropySource(p.rand))
+	if err != nil {
+		return "", err
+	}
+	defer wipe(b)
+	return string(b), nil
+}
+
+// Report whether s is made up of only ASCII digits
+func isNumber(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// Get 6 to the power of n
+func pow6(n int) int {
+	p := 1
+	for i := 0; i < n; i++ {
+		p *= 6
+	}
+	return p
+}
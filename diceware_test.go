@@ -0,0 +1,111 @@
+package passgen
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Build a complete two dice list, with words "w11" to "w66"
+func dicewareFixture(skip string) string {
+	var b strings.Builder
+	b.WriteString("-----BEGIN PGP SIGNED MESSAGE-----\nHash: SHA1\n\n")
+	for i := 1; i <= 6; i++ {
+		for j := 1; j <= 6; j++ {
+			roll := fmt.Sprintf("%d%d", i, j)
+			if roll != skip {
+				fmt.Fprintf(&b, "%s\tw%s\n", roll, roll)
+			}
+		}
+	}
+	b.WriteString("-----BEGIN PGP SIGNATURE-----\n")
+	return b.String()
+}
+
+func TestParseDicewareList(t *testing.T) {
+	l, err := ParseDicewareList(strings.NewReader(dicewareFixture("")), "fixture")
+	if err != nil {
+		t.Fatal("Error parsing diceware list", err)
+	}
+	if l.Dice != 2 || l.Len() != 36 {
+		t.Errorf("Incorrect list size. Dice: %d\t Words: %d", l.Dice, l.Len())
+	}
+	for _, roll := range []string{"11", "35", "6 6"} {
+		w, err := l.Word(roll)
+		if err != nil {
+			t.Fatal("Error looking up roll", err)
+		}
+		if w != "w"+strings.ReplaceAll(roll, " ", "") {
+			t.Errorf("Incorrect word for roll %s: %s", roll, w)
+		}
+	}
+	for _, roll := range []string{"1", "111", "17", "0a"} {
+		if _, err := l.Word(roll); !errors.Is(err, ErrInvalidRoll) {
+			t.Errorf("Expected an invalid roll error for %q: %v", roll, err)
+		}
+	}
+}
+
+func TestInvalidDicewareList(t *testing.T) {
+	tests := map[string]string{
+		"missing":   dicewareFixture("42"),
+		"duplicate": dicewareFixture("") + "42\tagain\n",
+		"bad digit": dicewareFixture("") + "17\tseven\n",
+		"wrong len": dicewareFixture("") + "111\tthree\n",
+		"no words":  "hello\nworld\n",
+	}
+	for name, list := range tests {
+		_, err := ParseDicewareList(strings.NewReader(list), name)
+		if !errors.Is(err, ErrInvalidDicewareList) || !errors.Is(err, ErrDictionary) {
+			t.Errorf("Expected an invalid diceware list error for %s: %v", name, err)
+		}
+	}
+
+	_, err := ParseDicewareList(strings.NewReader("11\tone\n12\ttwo words\n"), "fixture")
+	var derr *DictionaryError
+	if !errors.As(err, &derr) || derr.Line != 2 {
+		t.Error("Expected a dictionary error on line 2", err)
+	}
+}
+
+func TestDicewarePassphraseGenerator(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "diceware.txt")
+	if err := os.WriteFile(path, []byte(dicewareFixture("")), 0600); err != nil {
+		t.Fatal("Error writing dictionary", err)
+	}
+	gen, err := NewDicewarePassphraseGenerator(path)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	p, err := gen.Passphrase(4)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	for _, w := range strings.Split(p, " ") {
+		if _, err := gen.DicewareList().Word(strings.TrimPrefix(w, "w")); err != nil {
+			t.Errorf("Word not from the list: %s", w)
+		}
+	}
+
+	p, err = gen.PassphraseFromRolls([]string{"11", "2 3", "66"})
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if p != "w11 w23 w66" {
+		t.Errorf("Incorrect passphrase from rolls: %q", p)
+	}
+	if _, err := gen.PassphraseFromRolls([]string{"77"}); !errors.Is(err, ErrInvalidRoll) {
+		t.Error("Expected an invalid roll error", err)
+	}
+
+	internal, err := GetXKCDPassphraseGenerator()
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	if _, err := internal.PassphraseFromRolls([]string{"11"}); !errors.Is(err, ErrNoDicewareList) {
+		t.Error("Expected an error for a generator without a diceware list", err)
+	}
+}
@@ -0,0 +1,17 @@
+package passgen
+
+// Encoded and compressed BIP-39 word lists - https://github.com/bitcoin/bips/tree/master/bip-0039
+// Taken from github.com/tyler-smith/go-bip39, MIT License, Copyright (c) 2014-2018 Tyler Smith and contributors.
+// The words are normalized to NFC, so accented letters are single characters
+
+var bip39SpanishStored = "H4sIAAAAAAAC/zS7zbbjLo83+nRvg/Osc27nXJCMFUdpQJQAr6pMTt/KHu7BHvxXzWrq++p+10+pdyIFEOJL6Avn//n///M//vN///tf//H//utf//P/0f/+97/+5/aveH1ulHSlbdfCNdDGT1ppE7aBWj1o10CbVo20qQ0goxfqTCzSNjmjMLtGSrSR3SjRzkUoUkrcdaWU5PpdIyWWwUD5QCNXDJG4DbJ4fSbZUcxkZIFSvb4iJT0YBXXuQ05dKA3/bfICmmSR0tzFVkqTbUfj7KjdJWGAvchA664+EO1qWMw+qRJQBr/ry1hXunMaWMFd/k75LpVspbtYIQvX5yE50iEDlYfW65siHdrB4tB3rfGBBRwmutAxKdAxd12vz2NK9tKTAj300EgPNdOFxDiS9Iz+T96NX4GejFk+Zx98o0zJrs+6UqaygTiTFVoobxSvz7zNslJOlHcOABQp8+F0/MSaM9ugQJkHrZTvktkWygfdKB+6+0qzEPY/C44FxRPzzwW8Cj9BWq7vDUvLvo958AuN71PxXcxjGqpmqRoov0BWaMsMVAevVKhpJhQN6y/U/ezLRrYCydBAhdG9yKErFemD9oWKglcjQ6+WRQPV9NCVahKqXsLm1h1j1v36qpEqpHC9Pusxs0aqkrPG67NKAf313SNV9bXUwZVWqkOOCcqhT5BMypHq9HOpsybRla5/aIfgX//wUxe6/tFIjQ6QNDKCxDUeMnShBvqWJZEFagrRbGqDUfilN2rGdWd0NN2cweSOc2rTNJARehhGXMjIbtenbTIMa7BN80q2zY4BLT38ECyhm7O0XTI2xPap4fo0hqCY4DKbcL+Rlb/ia/X66oFMIVDWUNOu33UlMz4y6E1fkczmAQKcog2cygd1CtR9lh0CsFJPXLveqPMxjWyhzmjkrrZSd9EK1CWDVPoQUFQUtGyGO9IbG8rjPfuO1VIfE9LVZ8HG9+lcBj010qAfkxfyQxxgv9BgbNCQhGZIPEpaUDJiCzRMX4HG9acGmju9ACHr8+CFZqaVZnnrptm54rjmUFvohDScVBMHOsn0g06+0ck5U6VAJ1dCsQ+br0gnFEigU7ou9Avj/po7AVa90YvuuNALvcgCvRS7+rr+JKzjNe/GC71mXjbaKGy0qYWN0oPjRo/rG1WSOWz0JFs3ylRfBJww5AYNEDcq2/UnbFSTAu7odP2D3+Y8LWncyKq8UDC9bddnT1j+Rn1AJWzUp6E4KGe6bTTY3oMPsbjRmMOZ/slh83VsvF1fceNNMBrnrGHjTrZs3DVu3IdQ2CQ9dNmEa9ikHhq3jDnGLeuPyWHLs9PHphQ33aTSsummy6YJFeldseP3zgeFTd81GTWZTcOmuaO+bKiqO+1AuI6wYeumtV+fEjY1bJKakcVNO6Ro04GqcX3Xdbt+n4xV6Ity2IxODW734macHhQ245PjZq5TNhPfZhOMbZocFoqbKcRlMx2A84mq2Z12Dg3bTOwQpzm56rJN/rVu844Ti9u8+4lefx4atynDQIabtk3bYEe2admhgaBDfrY5CLs1X7jFMMmYYqIN6joRNjUk2gwwkd4S7dfnyRZhtistidhCovv1FRNd3zutiaRAWBM9weF5/a4fifKaKFMhdMxJNMD+KCplpx2lzIAFA2UFz3zqkqiASdkEtIXfkytSwaA0XdP1WZNPp+6SgThjDvWYBtIqCRyhBBJ04wqIbUqkG6avPSRq9LolajKuzxoTNZxtogYTnajN9AClobNtvk/XpyXGcMYD/O3wRsGdSWSVUWp/28zrBnh0VPSEmXX2+t6wwD4UQw21hG0YhvHnTjkkmh09X7om3hSKLvGOTecdDDjvdEvXV2aY+sRZO3blK+NyJnbdFBNXwXlyHd7HsCnu/iQ2ftGS2Py3YcVsMNZg0hvvITH0anqQn+KDMicFLu36A+y2ND0IC3mQeQH6ND2kDw5ubWJ6+IVNDwXnx3RVmx6zYUBJ2Y9E+FDArEsSrjG5YxmS3LH3cpAZWiAjuEJLksr4OdDaDJYpiWF2YpMxEemVlyQYSyaud8pU1JaUqYaUCbuRqTPgyWtyhwYlKXRL+fp+y49uxrekb9d0TZoeUjWmt4pJihGv37scuiTdNSS9Gwd3R2PSB0Oa9Hl91yXpEzSZYtKcHrQmzXwIeOUDl0OzVG/MZCFpLgqSWSpIC7RqSFqcbxHctut3UZyjloZLqnXHYJWfmEaFWGrVxAbc+QnKOsiWpA3kTeiW1Oh9/dWgv2NSg7eU1NzbS2rqkzKD3Gn34XvB1dGOzYd1YnU8QGKMtRj7uMbXNzbFGAcHoxCTScH5mrThpS49JNOit2TXb2z6LRkkBjfI5q5Lgp1Mk3bI4iQbjgYEYm74jQhjTbirRjHNt6qYb/GHq++VHfwmnyjIDtmbuDiozM2hk8NtXtOE62hLmlj8rD6XqZjwbG8t8KdNSNn0azNNtCtKb3iiZUhfdioUdpjdj50s7ISLt8PryGHnjS3u19cmed2vr0Q7hZ2T2LLzruvOd66dgKWKhR0u+rpzvsPw7AiOwIyzDKCihu61o1AH5XVn9yLXnWGKwNBMB9127u5dhJ07o7rD0Qeu08ftQ6rj8/oGhl1H++DKmMfcadlxmLvQlnXdBYEc3XZ5u+9hF6reaqJxl20+gdIgi/v7mu3Cg5Zd+HXb5X59J2yIHBV0mQs65ykgr2wYwRhB2i4mB/ZCekKp8/WP47vRa9nlRMdTsDbdMi+7Jg67ZrV11wI34mPXGnatmIq657yrFbBU62jtYdcufd2NDqim3fSgsLsl2OGZ7ZOzxn1i6LDPnHjZrz/4CTW3QyLiPl237rBE19dGVQNvJhrgE9gH1ChDotUW3mm/8f4OJPEDMaVG3mWoRd4nbDUj4NTId0n0+uAnr/zk0rLeOPMdex4Z2sRQfit+znyiZ5bWOV5fWZxIfgoqFZqHM+LhyGWbu0b2+PfGRd8xLZdGg1cuDStFMbOiaNwpcKWqK1cYQMePeefINV3ftDIiqkMD5EUj1zvtoLmzFeDDoyGuchSKXDMl9FQrQMaYS+30S29cccBYRR1vTsO8dFIHLeQzXl9NE0X+MaVpYJOXRu6JMgFxpZV70kx2455MNqy4J0g6d65JQHZnoxv3+2Tz3o12VDdoTe4Nss69aXc0Mev+Y17fAVrFFu7YWsQioB7zToEHNYo82CpmOCShrmKwk5CE4JPyxFrO92mdgtvBPz1twT8JSpJ/Ju4opYmRf74pf+IGAGXRlX96eBSvr58yUGxsQ2/88x1OokIrAw/jorf79bmZJFrxA37CndIDi70jsZLjHeNbuBM8vDs9Kdwp7w6zAnaNdw/slzsVdC+SheKdinZd72QEMb6TFUqCfqYZsINd53inIQfqZwU81T7u9HO9w4cxDXd3mu+c5fVxZ5QNXNj0td6vLxuYI9uptt65wwWPd/HMwV2gZpe7kIW7bEa3u7ytdriLM5WdNd4FIy134Rzuwkao6QMIoWi4yxNrk6cud8mozjDid6wyAw3Tj7uAaU1orjgNaOihwdM/4Z4pabxnmmCbsaLlnrGrWQeap+A3lOI9X3/QoJvQcocY3/Wg4chnrjjku+bMYK91B/RB1AwtNmCj7movTFuxzTp0vRslwoHYOxd1N6o4TPMzMJo7EF/fFu7GVQE72q9v/PbF2MRsJx8a77CmHP1+0HKfOMFZyG53ZDt8prPutN6nvec9/dhml7zerz9j0xzvc0AdHpQ4UzjoTj0cJIOWg566HJTpdlB+x46Hy9tBZcNvaOvD8zgoHF7VwcroyeEge6Frf3tQB/mlPGjo7aBTMjz7gwuDIRexj4PrelxflQ01VRwOXg82qqLAvtyDrXANB/eh6yEH9OztkFKpo48YhhHT9ciUhCwcWTeNR1YT+jg0h0PzXeOhWbui0Hg5tFA4FI7AoSbZC0bLoQM/B4MQUfJhtNPtsOvzLklRrA47xcNoSEfh5HiY8ECd+JaZYBDzZhkaD9Ou4Hb9AdFkzMRmcdg0HtNN9jGp6XpMsl0oHpPNKBwTmu+YHtwcU7QCdr0dUwaZoa1TBRPseXgQXJrH9blJjg/astcldvigxTXNg3Imiw8qlFAyqfTxoNf6oJfHaQ/ePKvzcPfsAd8lPjjTruHBWVAo7xYTWx9sBVN4XF+mHB9sp1h4CIz0Qxi35HF9w1daH3IIV14e8tTwkFL1hkBFTSg+NGGXH3qQrQ/FBtDy0Cda4BOGh9YD7VUN0FuN1odagUJ7eLb4obACy0N/aXhMThof07PYj+mZ1Ydv/2PyCSg7LQ94OY+JJayP60+B5/eYRfLOy2MWMKi72O0xjRDxhse0oUGSVg2yM+Uou2ihCFc76ypH5i4U5MjXnyDHBEXmg/JN8uxu2qXQwTV4tB6l4CyDlEa2SmlsosBuN6S0mbuuUHaNXuv1XXdJHKWyDSAo0lUqlBKvUv06RamSwKQW90ellllBXGmgtnMaepPah98ogXG/vjqYDymgH1NslXr9GZJvUk9hq/ohRouY9FXMc5KL9ExRetbB65M2ytd3fBLi9Pik8kZGGxptv75reJIZhadn7570KgjMnnzn9ckm9aD4lMpgplYhq0813Sg89eS6PPUXxedkOxyd3Jfn5Nf6nId7cs95KAoTBuM5sQXhOWvS+Jz1yISCeAFvB8/rT5PBFp7TCBBK5jlPrpLjc74OsuW/JGv4L6VMIdMmGqBnHBotuBBrph1R05rpIBj+fH0eJoVipgMWNhOkOlNhW/P1WaQSaHDW4FBBWNODbpkq3ifQo74o5uuzyStksgPd7fRufUhBt+EEg3+G7Dm+TNM4h0wn2ZLppUtmyrfMfw0wbCDHjGO3JTPmwgdabpkPD7kiHiCqxsz1mBSyZ4AznD9AJ9RGhhVz/8tzUAbEQvjkNfMvrjvdsmy4a3vIspmGLEkt5ut7Z4tZdiEgrpikHBSzHGz4XWjN13eBm5zFhSdLadhuqYkBdwWfyrRmhBKDlyxVb9kF2Df2+8eUHcw6usGtgNttFLIM5zQwIxwWII4pe4IqZ2jyjGtqQPWNTkdqb4TcW87zFFpgZ9b8dtSXrElj1jQNK/h9SKKQ9TBQlM3ktWQtGrLWJ3oODhnRDKBYyBOSBm9kyS4xs3Jf8mwU8+yIsvMc+pHnKxZKPCgUgkYutGNhhXZjFKbpWojRIxS6C5oOoaXQgYrrG/0zEjHF35dCoZzRnNFcrk/AzX/PEYrnowvVHVwrQl+UDroVqgLfOxZ4NLYUqk7YHQ5aC4xIBedGt3J9/pgwMMUZmDM1pljI7pKBDq5AgpGuTyua10KGW4XqwR09XroU6s6vJzL073KC2SBbCw33UwsNwTLx6AhmP6WA5JeC5KWWKBS4g2vhHasPhXfBsF87NFLhp7xrn2qxMOQACBe8cFEfgiu4ce1wfYoH04Xr9WctbIl2vRU2XCIGW5OhH4V7LNwhKsU3ngcbWofuipJpLPxKGFl416VApxeBlUXhoI8iqMgEoYLD74uWnJ2nFFBhqu9gHVoVarxInUNDEW0cintLRTqtRfp7t6RLBsQmCV7vigxdC3Js2I2/4l10p1h056xL0YeGoi4NilfMopktFsVFXIsW1xtFi6B/JUvoWnmnUCD+gE6AALSokbO23Xm4H4xdBnMz/929HZqmaE9g2j0ELTr8+P1Sluv3KXkp+tJQJrzUMncQTd4yhzI5Ewo2eC3IROHezMPQ9GRbyvT2962Y2aEL/rz+YcjMNMpLmaa3cv3B4wtG6OzwwIb/6eJ0PWus12ciWyu9dS/smIVK0nit9A4DKuFVvJKZN3XKodKgHCthWWulgSHXen3OzqA/KS+VTrA4PW1fGRa+Xl9pgAcU11r50CSKkgHiClX4Y7pUHhorz+EN0GuVTzYK1Z95quwaq/CWUeO0cv3DrwCEYb6H7BqqnJzXqlvmF4Wq6cFrvX5D+EL161E9OKpqg5eqg9aqQ5JQrHoS2OrJGEN9UnPjpc5Ea73+pMy61onnULRgtOkThKVf6uTXUmfGXP4UNo11Dgyo1KUH9Zcr3aQ3oCcPXXQziuph7U23znaSrbq9k2i6naIfmiiox2mari+qwA+ugxbFrmAzF03GUdOgE61jbl70bKUmT6JD80NoFbZt0V00KlJjFNQzE+p5RCAbQO6b6d1ztIviqBS+46LXt33oUz80U9TMuBya7zQ0aMa7sUJhay74pRY1n1CaWraM/LfWnRatLwraKOmq7Z1Hun43xk40gVVT9yuDf2OxXr/bkESr+oO23tTeQhtxM9UWhRBev/GaTYurT9zVGtT4SWg4qGpUO5B0UjtmzrqqeYoxqglUuyIuog/FKdiPdx5YbR60aifPYmpP0zRq51fVD+0a/JJHHVATi0Jm9eQnxev3ObPG6/dP2fWmP6/vgzGBXxgw6EurhkZpAMIsNuKcaW2IwSstjQ60XN8dVU8yXRtlf2JrlJPG9v7yol2fGeLe/LOKRlnfqJF9NKqhUaUMqioJLOB+0K3R9Y9/ZNOoXZ+AnNdGTXwKeP8eHBoZ2drIPAhvZLwDii2NTG/t+rRGO2aCvfKy0R08zXt3MkDGsO6HLY06mvvA+gZ4DkF/vx2NZnforSfqf0HcG5M/HTdOPhpEkUJDqBcabCOgWGj+jUxjqRwaI4fXOO/+Pt04M62Ns8AoNc4Z31k0zmCXZ6KlwXw2yL6tyGFCGzb2ObNabNzgvTX+4ancxaW0saWHI9iE5k9kQPAVGpskDGzYULYi3Us+vHXFCTOWy7AU7frqUsC/Dw9r2/U1KOu/Gw+7fmfWj8avtfFrwsttnq5rkh54NW3CsQnvmJGwVbT7TORJBYVMFptkHXprUsR1BpYTm9ShFprgIjZ5s8bTU5OG/uZnJL4n0v0Vq/k63FvFOyh2SV6JQsuEXcyQuOzdMv1yiJlkFsh5Zsi8O5gtT8hphukIDZpvaYoN050NUPCbC61NuSPL05TBVjPXW9Ms6V2ZIcSaIS5aoIWaFlj/5qNoaSCqbLem9tYXzW10bNrf5J3Z1qZdYH+b4tuopsOzvaEpnNoGY938AaAZJ33dmvGB0AzlIl5dcZoG1d6MT0zfpOi/m13fNUnjWzN5R9TNBLFPM6X49yudZpq4662Z7jMN8FB+Ecp37mr4cZgfp2lm0Bfu3r2+qZugN7RNQXnyRrd2/dmyX/uZHmwa2twhzHA1FAg6/q1OQ5v5IECxtc1c/Ghnbt7SdWkTg08fDUKYAxDqISoTUhbatOsr/pi8k4UfE1rwx+RCBmTsdV3jjyk89AbHvEgCDYImIOh5w9eDVYMR8pxGm65/Nf1qtEuiHAwRwuLbYVQaRXvn+4zqAYjkgyEo3b00ALu39AaWQxejXwAv9MWj5r+N/35SeDN+f22wGvvXLdHYv+IwTsQGBL3rX2y8FNgfcI2TsYb305OxP+99GIPLrnDQDM8zYqvxHdoHWK2AkX+1AzwPwWgHZTA6xAAzSA4XLOPH9VWDsWB/kJBdYNyicaaB5swnGGdBjGtQc06Y9RkM57AaexSxGBfQ16SYOZJGwaAXVmNobzBpjGjduKmP3IbkD+O+GjSWb0eXig69MXbYE5/GfeI1wtAZxaFWHdt7hrM60xMpHFwTfPJl/CtAd9riOtOED40wzDsB9UMXkzutdn0ffqRyqK0mNblQiGvrBdno1eTHxGOafzRjMgq64rjlpcH8mQ83LZp6DtZ0f0PW1dS/bltMcZr6lJcupk+NuGrmqLF9mNZgWjFPd2JMIX/a2ECOUbUn1HhwazowwNyub0C1xeAtGq5IsOlHPH1NUypFc79+sQkBmIhwbRasdbYxDQ0DRAMb33FPdg2dNjZA8d+ZQ6dEqDnotXZCaliXTplCp7xr7OQfNXXCVe/kVx2VuPGdcne64QRzR8Pp3MpGt07vDP7aqbo71KkyGdBhjFpcnKVTRfc6MG7Dzx8Trbb7xMkGPqXs1Idx7ITPaUKnWTHAT8XnSbf+Nx20dE66dlwuTInToI/Oe8THgGJLZ+mh+1dhnfNJsXMhjMHFo+PuQtS5drXo74PZkdra2f2b0LkJOkAdgTdqTHjtbO+d8STx2rn7BenvlNLS2QsDlbEjPtGl80/M4ydmKrDH3Z+LQhceHLocmKYcVdd+fWfaQJDxdXOXDHuDVnwV16/vsqkTw7h0McaapA8u4Da8ckzMHqYzdA+luu6iH11z7Jqp0doVORQFxsvx2q/f8BXRPMj+3RVZHV+jFgQgXevOaK5vqqqGzhW3aekQ9K4tk61d39+cdrVdb13N33ljRyV6dBwwhhsuCZNODn3iNbVP2LnQ3RD1yYdR6P5I3t2zArLBsc+7OTnCo7XP/BaSCSXWZ2OD1M3mIjzf6ec+YYz1o08LfRrEZtpfnoab1icMXZ94Fxzkn6gP2qAxwiBEk4O2688yKGkYlIYug574mckAnf6dbfSvF+PwS7MOKm4nBpVNQVkPFCq+//On3UENAjCoiRcg4PjI1EV0kO1oM0abK7pB9uThTToADSPaQMuY9AT5NEzvhT6wX3EwDZC5Wzw4ZbqN6ytVGNjBT9pR+2RDAWnHwZn+PThfX3etKBYKg4uCoLQM6kqvOPxr4vAORAdX6YBd18GKx7d1sGFZYbAlxZBWpKLZ6jQMbYY94S49Du5qaOtDsEWMzmHgwnwMfoUhm+htyDY9ozaES1MgXOIh/sw0BPIz5DCOQ57OAA8gcYh/jjPgVGB9UkAGX3fAMozru0lCfQPA1OTvKBAttA9+9x8zgwR7qzjndejm72VDE1kcmgQ7phhFDwpDM3ZXC9kytOKny4g2r2gcBr5RiuP6bfQzDvUwf6gVVx4wkQxW2Bq1xs7MDN2se92YB30M7WFoh3BqZ1sHnD3IpNFGT8f+Hu9fJN/G/30LHEaHQ4iNUdE4zL83fpvkYQRPfBi9yNZh1xfeXwf8W1qH8XsHjSvqfEHGPQyTbQI6a4GMG9TTOkxmvYOt3tlR8Ua3mMO0ESAug+kLNTOBanL1wp1uY27+rLuMuWkc07/tG7NsBAj5vP5UziuQJFrHtA1mZUzz7OCYBl5zqMW5eaR0/cmJjSJeDyjH+bZScEVuE2kiuGqzavDHlgXebZy2UdVl2sbrNH/yXaZVWmYnW2efZKLB35zWORRffXzMk5aTEsWTEj42OSnNSuEkJM1PnMJJmOlJnrpdTsq8nn/j99M/bT0pq91Qd84MytLEdDnJwNWEDAj256Su8WSIItBQ+/fJj+s7zYwKqYPDyU9+LSdniie70T85K2oqampiC6dfrpMrO6N6kNeJw0GAs38gc3myvyqfbJvDnVHFO2js6dCnZINtORGdnUJPjqdsBqaSRG/n9Z38YQiZwXjipUXjKTt28xT4xf5+xz2ccqh9nJLD6VmJUyodxssJ9XIi47cDK15ZwilGILQD3GzMPZzSfSu/e2PDXPqg2ymDPAN4CvywU056xRMi4AWx5ZRT11Mz3k+B3Z09NZ/YKzV6hVMhJacO/Tj1FU63XifCJwu/CHmyXzQ4/MINWn5xofCLreryi7suv6A1fulB4Zce0+KL7mIaXlSfFF/UaKCADydeWim8/L88r1k0vKbt+n8GAGsxbsNwNQAA"
+
+var bip39FrenchStored = "H4sIAAAAAAAC/zy7a5bkPos3+HSf40tWz6JmK1jGDvKRhAsJT0R9mV5O5TpiX93v+aH4vx8yQRfrghAgIP7r///P//jP//3vf/3H//Ovf/3P//tf//vf//qf9V8rbSStsS20Ud21rrTt8tujgiVnnmnTLCiq7VGt3sVQ/YqiUZNjpc3Y71EhfUA9Gf3Mr77Q1rgmjNY0O4puO4re5Fhoe7VG+YsS7e+fIjxTom/1hRKZcF0pJdoyGxC++kAylYFopp2BmKYHL5SSN0yd2DaeKD2oL5QePL6T3TPbREmwzKTl6vxF6be/f0zQwbS+CpbQ2dGvy4GCc55px+wrVvnbqa+0P94/sf/9m9Fxof3bT8yzF+7deKG9iGGmXbHwmXb9FD2BrrtxazzTbip9pt1zx0c31j7T+8cw2PvHtPKx0HGQGK90HJyCzMchsefjMPYnGhyEmunE9hc6QXab6BSc5TnO5jQ62L7otPcP6DrTaV54Itkxm5w+jl5y0EgKOi0kjWriBScTw3x7C5jJ4iRyekgcXmYDlfL5/rsZT5RPR1HePzX6S+HaV8r5/XNGRRY6GVB9lH0MqG47mC1fdNJCuYNWK+X7/aNYUSGc0Uxlk9MnKpvxF5X3T6U4g8LWHUdZZNcKYJQXKmpBk6KOTnrLlnmlcj1kE4x6ZXZbqHij2heqlF+YttL1UAvE0kMC6Ro7rkm4flF9/1DtYhPVE0PWUyVWXE/P7M+ZqhQsoVZ+Yqe1alyLWoO9qu5SV6paKGMlNVgR0MZnnWvFcF12BZ/Up7A/F7pIGk70AhMHH16ZKtj50qwn1npdZCx5oeviuErXpRbnd13+QsVvJxMvC/123j0tZJsEC9uGk57J9qCL7SpgWTvjGC3zb8eyrVB30McK44QXsqJi6FlG/cU1LqEZ7iOGMrkH1JBC1rhK+iLr2AjnhaxLAi+2i1NfqV0PihvSLjG2mVojR31ju7Hf1oQ7uK81HVe8NY8b17ok7RO1jiW17uDlzlnAC13BJx37B9Bo66DESr1zDZr2LnEdeze6MKRvJJUB2U6eyXfCh75LXBg/PdbopiCjdy04Pe/m6cEz3ZQxyo1rFdAEzHBzBdPfbI0XutmDl26hIZBu2Rn/wdG3hKS7VSo+VMdebpM80VMoz/QULTxttJ+8bHSgw7zRSSevG50epNpIzgoRulGOpcwb5aR12Siz1KiXRifPG25aXTeqicQwYt3HUDULO39tVKu8/1pU/XbQfwMbsU0bYVkbmdZ5I/vt+NxsDNuaYLrWRTF8pxBAG3UmR7mzCcb03YRt3UArqKONM7awbO8fnOO8cdbOv7b3T33/HJJ42dgSk89xQvjAMo5sYyu+07xxo+jV9KwxDuaeN3bD/mRjAyEkvcCFWyiPaZNv9WmTTHXdJEs9sRnJmWxfNqkhozcZN2/ZRK82YH9dgC259GmThs1K6+om8yaQVcsmfyimznSQ7fOWcUzrhpN5iK1b5hZiactSd9A1a92XLWuo7S2rN1BYt/haN6k8bXENNw1ugULfUapbdKy72LJpfbDbummVI45Lq7cFWt8NA1gM0zs+9KCFOiTapn5KlDIIpQ5ZgKI1Xjf11jQH0mFXzJs+2W3ZDNvhJcwHzGZ087wZb9KWzd5/04PXsCmCvUyS4qpsJmcwqoHYIIIJdP68GUZfN1gAjq2aBnuY1j8DemuYTl+4Q5sFqc1brMm8U0bxRbXPmx9H5mVzaXFAnjN37MmNyafNDbzqrQd3e5cKonpXkNHv0JWb38GXCXaV1jkRzmFNYYZ0nhPtGCvRbqg93j9gygS2VweMqRPl5Nh3osyXVEDZDJ/nwoZ2LVUwQr6D6RIVMtoxVHn/GM2JisRI5aKz8pSgzZZElbtWlBSj1j6mr54Js12xiUSXWvS+DFcp0dU8Y/arx6IuiLE1kcXulkS2aewybBr0MNliPwaNDKiDKma4k4msx/wN9JhT8AIAhEMagntO5GMs72MnN4VZkuhmq2i/hWxN758dQmNNLDXUUHr/ZG6YjnP2aKlDfCSuQyckrt0oz4ktZf6V3j/2/tk+NdBxCVOgn92x4M++HnSa1Dk9SBqjGAZDeoQFguqaeE0PukJ/pkcQBhWtsRs69FH2cc4PusNITA8u0vApV2zmKz1gacaaH2z4m9ODb8oTDJC6pIfA8gM8a4wkBeIXzdrX9MhqQYuHwkzuS3qoNLEpPTTmUR/8+DDoifRwFOQkjCknZOKawlzD6qS+fwoBdsOSxII9pxSqOEkI9SSdrQbUF9c5STewmtySl5RJDEeeqQTJMlSszSmDNCjewQJZuPYlZTnrKBaKIsTBnHLY+SkruGpNWcOSmZNulPuUdDNakoKBeE2atEPEJN2xZt1Dvs1JQbg5YYv2lTQMeaxM5TiwWZXQykkzCAoomwlgjqusuVAfHbRynpOWjfqatIAT0aEUqriKWi5KfUmKAdGj7i4xZD3iaLWeYZIlrVX7QJrWGl3659ubn1juRVKnpJegYASaqm1MDhjKOKlVPArQfnlDvcF2Smr9/Tc6tCJxVNqgc6akIQzUY7V+eSzOLe6seh+j+20Cur2085SMNl6SkdQe8CacgNFL61fCu6K7cWC7xJbs/VNgqyZjb1HBHxlpsJZsShbX2qR1ymsy6SD8nEyDWDYUXDLtBOFkngT3wJzzkuw1ZJJvoQiSs+Qs9pXwlBkH6NIE8sLlxo3wXKRiHZ57GKHJi2dwhl8CQrgRrL7k1oJVX1ShCsMSWNML6hfDvmrQcqfBqzsVCVBPtmWnGh/v5NdD6tf+/oE1Y7wCA2GiKt7WgZn8g3mn2tENwj+qEscDB9gjDFFg//RPmf6p0n8Qk093vzIW8/7ZKUfN7vFa3d8/3FrUHFzxkAUmn/kOo9cYCfZiVJ2g3cCydxnYeE3u759v9jrmyZJii1lPjMBxEwJh/6yuaB7fB7NGVVWPTrUzJDlqfB8DXmQdnS+ubUx2PegfLFMaA1z6qbIgP6pMt1HVwvBfd26Q+oxBG49BWxzdL2C6vX8Ea4BFGotp3WSM0OPREhi3z9F19Q9Beii9deePOb9z2PGAEozBt4rNu0C3rrtQVpiNu1CB1bFLiiHlOMajf5czkGWXUzrlaccq511yEElKWFBApHp00+drR4UNV8QuJkEE2Jt9IEEgIE2ugfTQVTtuQMdqZTwpdk2Sedl1eEF2PQtPu8rZl10LSeV11yKfTiV8MrvW8R7ftX5rBQgS6kW4asuu1oMEoZPmXeNNseu4IdqaRO9Ooeh3dapxldS3mMXTGBybmfZQLrvRqQEukCCOwNbdZAt3UThV3HjZ/WKTgJmfgBaOj90t4bhfFW8PXt8/MKDFVlw7w6Zw2cguDgRs+RV3Le4fMLkaL0DUBvTGMy7h6KlVi0SDWopBYvlALPj3/TMk48DkJqn43NQBvMQobuySV8jS0Bi4wCEN+TiC9zkcQnKgQmCP83GoddSPS8zH4dBvX7jMGUe8ABu7+A5n0tf7J9OmoyqTnRJVnLrFd/DWUO2/ArseML+B/r2xxCwnpHhgXVrheVx+VHgIKXTGbV65bJTzQBhCeoFce8UchY18x1cFPKp14VK4xpKK9rGBoo6rtHK52NgDyRq7LB+fBpfLpMU4njHOyjWMbQPyCIXAFeZGQNgTK9ddTo8eYEaMUoOBFh4Kew3/FZ50XI+4NlwPthI9D3XspIaonLiegjnPrFtsvcpZ0P+byhbt3+wL18w328y1cpGVa/UXvHhcTeKxx9V0g07n2hjXf+Xaw9cBBMvjmWuXUQ5RtIZJe7P9gvQsQ4RwvWmMd4f4QQUINnP9AwcT3GnCoC0cSS34/yLDhED6OOuLPE74ksQm/AvYx18LNDjokhBrUTG+lqb7+KrT9cBcFzQ5msJnjCZTv8fnLnjcrrB62eJG/Xa5AIcwg0DHec5odXxifoW84JbGw4DbRTs4p13vv4m/uF3y/ntmnrldKgZg0lduvz0UP7dGrwHDSQ3Zj9NpTY3RvUvhhVvXQgnQKHbU6QxGApZjbT1kcjTFcxcYh50QWN01SBsGMq5zf1DVgaD7zP1RQ1r0j3zpGsYoEAnad62Vag8snKPoFNoOncKH/P7pvvPKDm9lyLSbQmcA+ciAG3wkGZjs2PQCbAiBW6HaD3THQdnET0r9i5/0UUj8JA+h88TLaiAS1go/If/kmPmZvPEXPyG84kI+cUkZ0FKUHzQ+fcSNXfkp56D+U3J0kDbqdZCSn1cY1qi6so6FDH3Gz8uksM38/O3SVn72Yc3ws1s4qvjpGVrjCOV70Bbu2AXCE8+fg0KRHZRCWxwkG+TSQZmkobmM3kVydLPEjmY7OPt8kIHXDzKNB/FBLUmN2eBXOKhDrswHedIKgIjCQTe7AajJeoTpl/nrCJ0Bm+54/+xB7/mAUK3TwaUwCgVzD2ZajveP7pTnjxyC7aCJl4MNw68HmPfGInj49A/2boz5bhOMLdSSLockzlEMLXLI/v6L3nI6eksenl2Yhh3y6JAcbr7lwKie50NywY4lq6MyHoiH1LCFpkOq4L9mng6xgu/idT8d8sSyh0Kdj0wFjTlcAOuRqYdBceT3D/ly5HAMTUc8t4/MT0iCI2vSOh1ZscLsqXsM5bDij+w3ngmHZuH1CNIOJPQVkE5SeT7UwNOH2smmFUg8BxboUa+8HDBQQBP1HROpx+0HInl09OwWHazIctgIuhwGHloPGw481F8XB3zFERn0auflMA5D8TDOWhfUF7FfgBU2KoYaNLdhQx6G04qu4S8CvIPEprIvh2nBC+4w7DED9rgD5tKXw08ECqbDBRfALUjqJnDqHW7Bo2EyTId3t/mks7ItJ2V6CgMGJU8qG0Gtn2QR+1hOsl24zidZlag38P9y0h/OmaeT/midzwiVfJ0wNcL7BMwzryfXnawwmios+Yy+VXg6uarPJ9cuGa3Dv/oLWHn/7RafGFXxMp0MLju5Rdzu5Fdjm0/ZJEDKAYwOnuJJM52ZEs9nJrl5OXMERafQ3vOZVYzXM2sQZzk1H+w2nQpePbUeeL2eYJzlVAsRcYJJtAKGm+FUz9rXU/1COxDDmwgNeAOfRrtzXvGWOqTLfBpJ5ek0qjtaL7j/TqPu0tF4i82ncaW+niYHzm85bTDiaVKugDqOzILt5zMYAMAvAD+j0cN+OM1fwc+nh+7f19PZTLA13KT1dCnkoI5Lp+jYoOaO9XwVmM+8ni9T1K0Pgjd75+VBcDjZFEGb+UGFyecH1ZNsfVANL+TyIJOk+MqKVkE/u7TOD2pk+/KA+PPy9YDzqcNN9WDbGOUP3z/YCu7wI+Kka7hyOtvyYI+o6EM2Ngjkh2zq60Ny8Or6kNZVjKeH3GzzQ0vMpwX35uuhRc/338rLQ2tlN8DQOw+tnf05PdSwRzX5g1WoweIFLFp5faiFZ2N56Aj+PcIJPT98y9qXh8uo9UJSAfAgeXiRPYC6zQ+3zLY+Xrtp4bw+XqdgRdPjVbCu11W18Sz7C0wnZyxvltOp8io5S5IeyLjIEvJASoQ1ZilBJCmFa+NVStFNciAejyMJv9WXlIgqUl6lfBSvlKF4pQzFi4p41khNZHUgXHdhIIIXPZAsnybFMIvUnZ9YSN0loefHKwE34y59kcpXF/QLC2SWCj2ySj1y2IJSIaQHEhtcpX5MFKlhXgBir6vU8caZpX67Ya6qaaxKh4tdalySVWpLJrG6hk8Aw/KW2jQHOeuIPQLpUhMGaWFezDK8dVI7SIqiFHzQQwICcRk79B6krsMiAzJ8rFLDDENFGF+rmIaDaRUz2gVdzN4/nAElJm2aAe7wk8k9Egq+6XSy5ZvABjbFu2P5phr+1W+I6Dp/k+Ox8k1e0XYz+PKbO05/+o4IwTf7Lms4dlrj5VuH1+tbawRwv7WemPxbnd3Wb3U8H47lW90q5flbQ/t+64t8/kbw5bl8O9jMViRKFK59/vYqatO3X1qXbzdpnRckF0ji+dtx1b6+/YYSyDz9m1707/nfUrTq8m9RWApTpo3znGkDm+IVG0+hTGmwQ6bUcVEQS6voLZWX/El9ySQRyskgETlg6KlM5UKvGsZepnjarZlqmO9TJohkvJOhDCIHYsnkYVVlumlTABVb8IR24zke0DkApnv/nI5vuDW5ec7cOzrxHYvhZ7gV8/vnD9k+ZwlvdpYtdjRFHGjJksJ4z5LUKs8wyk4U339vQ+8z7LYly6k9vjpBrLCVc9z/NYOQtKNPgd/zK+P6kWApUk/taxatKQgjv0MTZGlh/M1ZIgEkS3+Ilxkvf1BIeo+4UQYvgnR6BgdnlTC25hwxiCVr2UzSkrE24SlH+DqSPuas3i2qbvx/UZ6yb8Lz8Cas2VO4Z6fsMYWfHgT5vB+zl2GfZq/Yy5S97jJlN61zhsrFN08HPxZKD/BDoZOaVECunQDjUVZITkOz5Kx1hbTuwhX1JtEMHVToO0RjgX3E9lUofyLQhTIU0VwovKKFco6we6FS1PtjhSFAuPSF6rDsCoLneHQXcBv5XKg657WQUX8o5rbN0M8QbNonKC5BUULilIivx5xmsWbrEWEosPZhBBTCPf0q9Em0WAv1Ebgv1A1BmaWQ7xIV3hrtPA31X+gphfJawjNWeyBaq38h8EFh3gDjJDWQ0DJAvPFaIqvMDS24UtE36w5Chnsb5RKSrHAlPB4KV7a5cIUHA7pK64netSvKlox3wQQmnafChgVwa3TGZxFELPFGxwr7+0fHLP2hO+O7LtExvIxFKNRBkWQw/kpkk8wlwmsAhr6ShX3Ba1Bw9BLhlKlIxfnKsFqXIiPZp8hQywVSHzMYJSxRTAdpJB4WU5EnZgolvBTd2SqvRTnHU7Vohc94LVqlsxsqKgl6VO0K/tUaHu6itdMZ31YHmZaiwQ1LJD6RA4IqgGPvGk7eojD6SzxiAY4cIONORCC/ogiLDR64OHrPXS5sxquEF6a4BQssxS1sp+JW4gS8pahu7x8va/EmSRgfDH/vVLxjKd6d81JeJrTzWl7WB0e9Wh/s+fr4SiqF4K14Ta0VDK/S5kpmbGulMepc/y9wQzfHsyyQ/hnlFuOvioBLmGOVUydbkT5CrUfLGWMEFoZHYJqEbaosJy81XtvPtXLvkRZZ2Q1HUtkj+Fn5Zp9GVK5K+jfnpUo36ijeTD5Vhc6tmuRYq0JfVF6riiV2A9KCD6sWkKVCcsZqtRRsV61EsKpqKO+14igFK9GwcJeqbiYoO+Zbq97hqwcS926uekviqTpsxOrj4V7D71ldWliyw6GoS4XPjfJU4YVeq/dIOps/wRPdPsmQugW9Zt1actQ3ECqQjjuw6NYjRqJbD4WtKYU9tGhKfuHLhGfioqkrZIOmbuHoBYWuHIiH72DSyE3TXcOyh9usNrQjQVIw0nGY2KQndC3ISb5CDYVM13zQWHGWUL+gL+igI4tz1Zp6KAutkT66Ki45zlyrRO7JpBdlnvUK2anXMDz0koproZFs54GExTxFNuiiV7DhopjNn7OGK29W26Cb1UYIZVEbybhqJ1X0Pp0lL2oCDbOq1bCkJrVYiXXhVVvCNzZrK9pQbnEJZu1kwkvEd6nO6ta0TgqLdFXvWIkt6vcgwD1u54gt6fMVL6JJ/2jl9aLBF/NFmRJ/XZQL2ftvW6+RuxktF9tyUUXwbLqg6taL6qlZKpCRbXxRrTiRi6oalejSKWudL7rohXEuiX6X9gFf5pjHaNcnlmLhjFyukXyCisML23yR5QF0NBvFzNYYV+ci66EpLjK3+G7E7S6KAMTXRZA84POLPo7bi7qEr/IiXO/1onvw0QWDr7FNVziUL3pFiOsK3/1ysdRuKGbIoCvCpFSnayycs3oLCAG8XBys9usKN0j404AOeg/3JsqOs7zCvT5g9rJcbLvJc73YDv18aaI7oyYELZAIhc0XW5M8XWydp4sb1j082tPFXXqUTGy+GFduuR5khIN5KOyx6wHlAtCj0gjEf7xaXIpLqOp6CQQa5RmOeJBN2IyXS9hv0EOyYvEyzMxLrpB114c1xCJQekl4VecLj/a6XHKHSXvJk/N0yZ8/tCAQjBf8lQmePkBpWHmmygHiGDM1HBuaI7Uh0n2jnZ/e5iuykacL5ulyZa14I13ZQefsJY5ORx7XcunwzF36/osla6RDXCoW3Krhn0D1jf1qiKr10jxk0qU5c0X5dSoYRAvEOyBItF5D7OSvS+vwBU+XVn6tECZhUl/aQu9+XdoajC5M2sLmv/TD1xoW5BXRs+lST2iD1MTCvIAQ6nbFJx6JmNcIsC2XhYm7XvYJZ1yGjAOIvSsSO7AZg5n7HEh2rN4QyS/ROQIqgXV+9tEJQ6+QgqEzLpO4SSZBKRMEgi7TLb//Fnyq6bMv0x3K9jLF4a6X6QlZM12msUb95mBs0whBARmm42V6WQyFiNDAejzC0MeHuDCNlPv1Mh8Bkct8iKT2imSp+fItS1ouT2xYqo+b7vlCW25ky+U1MsKuMIeO5fLIAFsvt5F1dPmfP5nX62UUfp7fTo1sBaNHysNv58iB/frtMuI4y2+Xjn39du3Ctc9GuAerUdJwGYRbwJ9rhMJ3qYuR7Ow2G7i/rkaZaxcDEty8GpUIIs5GePLNRhBTK04Z1YvRHVFMozu+f41Avr1/hpYEgugwYMSSA6tS0IlTJDqswSp9tI3fagSmuY9eFnEh43C/oAJ5NfHZHt4d4xHpX4zHjx7AaJBYGGY4ftBk4GPjw89oQHCbK1Z4Qu8CZvo0eRa2X/b+kT60s3GwDKB6wBxWlPGIyxpn4WM1Hu+pxbi8/4JeI0vPuHxOALEtQf/i8VmFFDKuEYM3rnKMPUa2P/rXbqPjrbIYX1jZavyJXhvjOmC+q0vGfJFgH/fJ7thMkwq3CLCIRyzGkSE/20hNQYPnTh0YbELBsGFtRY2A7Ktx17LF1N0ikcDeP14/lPOIBFsktDwwwx2ZU3GFc49l3J6hiBCuDq+TSeS1mgT/ySk7LyZnOKjiottqAgmFXX6MB5PIwjWBqJsM4arV5B7v9/hJjz8X00KQE6blMp5MRwH8Pps2JgcQDBsJJMdk2tVm0+4Z/f3EYBGqAcxMDhihB4N7qvBkvlHFf2nTSNE3D91sfkays3l4MM1xT7DPyGQzb+MxYa/+KDy3+K3S0mgLDdVoM14aRZbB3OggkwUXDgRrQ0M1yrSjlOXmqYXl0yJqOzcqvMvaqEawB8gZ7NLIErWCoW3HNoZ9hwY/javPjSDeAXqsJ7Kp50bxdmt0D+MScfOL89oSjLPMv1qKFA+j/gW0kokuLfHVsY8UfvilpTCBACMNvSWNVgNJ15Y8h4HbIBgSrw0JNXhCtMijsaVxUmf71UYCTMe64T9AQAFIPDxG0oPHMHApfrWRkCLH0jiykAAHtbnwZ6IilTK6jnSktXENTgPS/+l0kXGM99tRNTc2lro0Hr9qaeEW8ScqzI1nVHhBEc8kLAgHg+pbMHuYy2vjZzxM1ib78ALAVYanTpPh9mtyRLyoyZl5hms5OuWxLoFl4GVucLWjXFO8eptUid+tNLkeWqcmptfaZCRyzg13x6b2bwHDaCRGNk2YQHfxsjYNQj7npnmnDsCS1/BoQ8k0zb5F9xLMqqWM9lI1Vqs1etUzCKq1honWtMapq6Uxs3VB5yao7B1SsqmnQUn13aN3JNijIcQsKi66MJpbivqR/tDUwxRf20WfIWB5U17bBeUCwl2RyzO3S4zy3PrgsfH4X1pnq9h9l+LZG5ArcjxbN0l9bd33MXD3K3bWX+HUXBqUfeG1+da6gWK+4YHUfBvPg+YpwfponrAnP2AEfTU/PxGI5nIH53o+KAa8wr5ofoW0X5rbQdiumyTqgYxUp+Y25H9zw92dm9sNinqI+KW9cqaNl/Yqm2b+aq8SJjpqKl0tYKcnry28JoWnThulJfQA24IghWRexs9sbO5hIawdyrxQXePlVaNj2dQDSmNbO42w+dTpkrbi91ERsu5kkAGdTI6lk3Wp6AMB1wkfr526OszqTn5h5vFjCqzSlh4uv7r2yN/ivHausbT5k+PUubLbNJwFkduh6B1RP1RbZRRHuK2/f8b8sDjn/gAB1v54/6gJfwExugQtavScumxCM5yfO89dYJatXYyzYGIxFZtgF/ncpRPGjaT+Lt2hObtuep5Uv7qGzKx97lpw4n0EbgDDkOx6jV+WdjVogq4Gxd3V4sdXXS1iw6gwPOa7Wote3Xnq2rksPXxzmNTHLyp6hBRt7voU0MAolMTcjQ5JKA8fdjd6YB/x9u3jxze2dKObJC/d3n8PzGyRUofyT1Obu7FLnrsJZFs32bxSBpIiTb2bjB8XdhMtSO7qJuGW6Sahi1CBCNDaTcumoJ5pxcL0kjTq/QpOcMF5exawh8OziGKtnJfutklFMTyf3cPLNnVHVKm/ykV17i8IxqW/4tk49ZdRXX1zDuPCc/jFPXejpnXxGubq4p9fO4XNM/uItHmVTmLRerO1xUdSwey2gX5uXRLVPjm0+OQN3OYN1otHUksE92bvegkvNyWywvNNKUldbzoJv7ma7vhdF+gfv2S6SWqyqAjbYrkpRxT2DhMbIGudbso3xiwXFnhT/Exkvulit/kmEwZoEPU3tUZ5ugny7OZI5F1u3rl3/rrfP+fwid/vn0dYg8vNEobrDY984vWOy7cLutSRinRz5FVDBBa8Oe5IO2z41nY3nm/ozTrfbFXQ3UwdAE+lm62jgPc7xuxxX1Zg8fvSm5+g6h1B4Vto9wRQd15vScOPeUtk1s839KuuN9QaHuK3nBHwuCWT1AX+GPDsLTWiN/MtmhX18QJcb7HwmAHpro2nW8zbfI/fOd7SxmAhaO4wUEFRiUO+pVNebhm/Rr6lx6/TUGHg0ltuyPVbbrkIc2kaTuhbT2jpW8EdcBRhoSNBeb01D4l3a04giuYevtNbs4N71CgF6PycwvWx3OpZQWV9QRYgoxNxy/8vUqKfeITX6UXp0ec/8UPs5Q/8R/0x/eHWef2jI4Hm/wwAlDzN9RpAAAA="
+
+var bip39ItalianStored = "H4sIAAAAAAAC/zS7W9LsKowmuqsifck4Z0w9FxnLXooAiS2BH/KlazY9y6qOT1798P9cDUJ3JPL/+9//+R//+T//9c9//P///PPf/+v//M9//fPf+z8LHVRsp+Ogu0pWRGnYQgcPXumQCNvosCrOOx1OIQ+mud00bKdS6GTnjUph1eyYQcM2KjxMMfBHauWFipy2UPl30ocKFisuVbB5cTto0EJlDlvppBuQnCcfku3TGu908j1z4ZNdnBc6paPbxiAAcM5KWPa66JDKG10XD8y/LhwCA04KmC9ziUYLXZb9LoXUFrrp4ZVu1sEb3fxY5Z3um7QIRgW73DJyl9tUotlOt0uxmpXZGPDe8/eznSod5CctVBPqetBwdB/sNOxD9bCV6jEbf6gW3qkWe/FRAbei+6KN6s2HoxTSwQtVOWSnKo3zMLXS/W5Q+fYshZ8csNMqoTJBtJVqY8UEu1nR7FQw3DkGjlQHVVbsNNhByjqkFMEXww2oqVNzgYetYoFfoqJRvanRRo1URpbOSis10G+jdnhyVOMDgwx079R4SIDoTQomNUpOa431xHATf1dvppKfG4jeuthOrVdJordZGVhQKuRnVm6ni1GpWB8VL3+EVlIaTh/SQhtp4VrRV8xRnOSMwhktrjie3gy66m0199J7BgBRaVhW+U4AVeVMgib7q05NnKmpgD90SJGO4Z9s1GkkiTv7mE4LdTt5p97JU8B65yE4UO9231ind7czy6ngru7J3E5HotEhX1jC6bY8r1M9BWd0eiFxwlYrOf1+aB6SjAisPGIr+ZlkctZ3ZQgAum9RRjFxOBesCFpgJeXAls5nsp676I1xtwDwHqxS8FWgNfiSgpV/UoHRKDJTMqNYxVbBPWSn4DGSGeKiCkyHQCwjuTi6vGwUHdBHEPDzoQAkEVZnrhjTT/QCEzFYU6PEEAhYDKfcd9CT+wyGDG80DEy40DDIxRgctNMY8iTdxzBXzBqeiBpjEhA1QyrwO2MkduZ4GW5iGSw7x0yeeEh/CfrDoPHzsArY7Xkk7EvPY/VmdPzElBf6GYD+/QSL/H7T3daDDq68HVQKKZpF1D5Q3ttBJ0fQftAplQZtB92pww+SbNWCZQ+qJz5MGT+o/p1af6RYox2SpZ5gxIOcTE9M8IMca3nBFkBBzvM6G+BxK8X2gyKV+XKkGB80LHL5MWZCMit/DnpoPeixoOXggtl8XbQeXG9Boz4YgPwfrKl/t4NVbmzI+hOlzwFjc7DXtzFoPeSQQZ9DiqyHnKa8HnLJaZ9DbtoPqZADWg5ph+04ZbFq2yFW7bb1kM4n74f0FPP9ED9S0R7ijs+jGJjmkGAw9yGhppodBktxSIzpsh/y+1GSqpKeWQygwGqFRB+mciWGzE/8D6DNBkFStsPGSPzb75fQOaXqPRxmzpbDqdF6eGqvw+mZgM15QI2h8vAYth7Ovx8myF2FvodLrTjMfrikjtuPpFfFinYamonX9fBZs3cCinkcMEDHLLQe80zI5nW9fSDVrAf+JytMr6a8H9OdAuBNiCMtxwQLwD8AaIXOWWwvVKmR21bS3mXJEYxSDrelUG3ZaxCqQu2YQXuhxk65XJPyDjRRLNusZrNT5bWQUkdTT670KaTZhwMU0iRkIR04caFOBft2qPu9UJdhb8VuSQh754SoO5bsMSsg8fwQlUIRtKTl2QqlU4Fue0iz4jj5t5APA7/uheIlawGi8jTB3gBnZGcAkYUCZM2O1DSYGgMQD8huoeHUeCk08zhPatXCp6TfU/h0wmy+CItw5cP5C4OXHtKnwDgXVlP06uCAoSrsQC1thb2Q81LYQTaGW4PRJ61i4TChpXB0+xQetpQ/XGmFiXVDUQrt5Y9wuoXlj4BwS/mT5/8jPv02VAa506cI2V6k1FTERS7PrSHye5EqejoqNkCapYgDQHG3kK3IcCn2LTIGnckMAibdikAaaC3ySOW9VIqAkJUqKoWWUg1khSBsxU6Qby12wkYUY0//r9it1lDWtP7FaiPgw6q9zW5hXxgweQhosPqjT7FGa7HGg/ZiraUmKNYga8Van4Md7alYQ4sE+vWc7thcL7DDtxh8j3dblXnnkHLkhmpR+B2K2fLzITqz8jB0ZbEO2qEUqMNivQtW7S7g7q0YfAFCeVJCioH8whWmupjj3Esxf6c75zk8JL8aDIm1gNnci8WgF2cjPY1iTy7mNGDwitNDSQ/nt/8vbzg3w/mdo8igpThDJlzAVyiVKi/FBcR2GdCexQ3wuSmBzxx74wQ+i6QC8BmF1jILTGyZZYJp550aYMLoodkNkjydRhJzehjYbvqDWVHe2THs5M9Jpy0noeskMAHtZ3qx2aHClVCqRbbhE6/A6kDzIR2C8mG37eRjjmH7yYVVxdaTwQHbyWDNLA2CfzJQgXln0mU/+RIVrMiXeePlBKH3k2s6odvJ9XoXqOJiy8l10HZyY/3RfrIa4FlP1uH4rFtILuvU80Ds6eysJ7vd9D05gPDcMNiHoUcwCx/FbOz8PXmw583i5MewnFDj3EAKtwPLCZ9u6ykX45hyXTMwfOcVDhUZ0HCn1PlgbdG8D6Ci6TGf0kUBh/QK2YI1rBh3gj37wFvZTnGDjTnFZ8fcyCvkKZF4RMeVaJJIn/6U6OmpnhKDEkUSs5Ha55Rc5yFgUx6uOevB2QHvY56EtaOa8nZaKtD9tJuSWU+7Gy2n1cLraS1pZ41VCqEimmCYnlbtcxo2MW+Cg9jAyBgGvNkzc5sfjPNyOt22nj7ltPWcx4HDzSOvous5YUI/58TEac22c/YKZXbOdP1t4QPH4VI4wj4MvQeOi5Cdi6k1oQUySRsYDrabT2hOBjZc0D0LOe98c14BPnxX2fi2VNt8O99iX650JH52ruQ3KJwMCgxxZS3gQc7b7caVk+HAuMUWrlLow7XZh2vQwnWGbdxIgW5uR+VGKzccYOEmjk4b8tjOzdJv3rl18VyszWo768ngpZWhXm1jZb+FVtaLQjZWG1xog0gkIPqTRht3GjJ44y7Vbtu5S9gptnE34Hnl3icO9u9M5bGyk4t92A9a2Q8DfFBwGzs3GbSxg915Z288cCtiN4waRGdj99SdHHSb2sJBLVsqjXcOmuDwDwdADc7rL0SyCPAS8H3RIRm1QCUGOy8c77hdhEOE+aCB1Q1ojU4a6O4OhG4cAUXxQWPlGJyDQxq4lWOYggdiuOXArBlx4ZEu/8ojXVgeUL32AXl3nqXKybbydOu08EP4FHKkP9r4kVSQ8PFxQ+PnkYf2i44jowNXhpNO2i5cb92Wi2qx/aKWju16pbRtF+lFTstFemNUB0WjD2R9v8gvgnN8kUsq54u8UbH1oiiCpWO83QHXd79oCJj8c9FA67FqYevFBzTZBdVMn4tP1G8g8+Laab24DrftYvijtF1gO2zPCrm3HRadNad7zvMBL+3iiOmYH+Di5eIxaLl4nrZcQgdtl5ywaZ9LLtovudNafS6ptl2iqbUuUVCFVlgH5+USc94uieTUSwLScAkualelAiG5KjVWdFc+bL0qt0bbVc3ltO2qE3y0XnWa23rZAWV8WaFSgHYrFmhDVwyM31VQVBq0X1ZLOkeX1duAA9Mz2fIyTdZfruSky3QQcGUO9v1e5uUPzo8ejrdMX/QyVxL7XObY1kdeby5zWNntsriSEBaAyik96OVyUkIzQjT7053+Xg4NmNs4Kw1aL+cAVC5gH7daRW29HHBvl8870ehz4HSz5O16vWYJQft+I4XXrE2Ul2vWx7YLZiR7W157rtks7HNN4H7qe8O/pmHb6Qc+8NuU12u6gfkmeG6CR/Yb0VhSW27EMrebKg02lBWsdlO13tFuBztmtUb7TQ7DSctNfhiadpFilv/AADdFWpntprAqmBHpRy83AeAbGgfFj/Pz349rpc/NxfabKw0YpJtr2HZzy3vKDR4a9rkZ67NKelU3qxLWZ7WBO+zNftt+/+Geobr7j+RlaLn/SNB6C+ECeks9abtFubttt1janVtM8vpxizkQIohH3pJceIub8nbLSFfwlnnKT/Dx/Ds+A+etdkxAW+cAvW61Zsttx0Gf2+q13tYE86w1EOQ2vQCdKXBtDzuw6FSk8nI7ndmC9Vpvp9ay0JP32+kNSd9Oj0X2J0GcC62wlTfG5MpdXG6g20V/GLQxUMzebb8nnXTjuJMwZ2b4+p7k6frc87LtnoLGLgd7Bn2kmNIOPZuxNDmlVrFF0uOQ02mVE+Te5fQ3ECy3sPIqt5LbLremDV+kwixKrRy2S61251e1zvTepSGGZLu0g9NZkXZYKbZKO6DSpTVSTGvsWKA1y0C4tE7vtM5vU/jO0nxgXnfTQZso1TyYkpe/lQFVuYmWRIQobIRkxx9IumiR+DujzsgOS49XtLgVsVW0zAPtk8Bji+gptAr8Md5E0+HZRC9stYte8heGq8rILe8MO26id161RO/KgU9vA1OL3m4RGFAO4F/tNOevqNV0NHZR0xN8KRqUS0R6RKIh3DAepiqEysywgeigeL8YnKcZppSLjwkFKjqbZNxO9KEKL1H04cIoBHB39sMq79LVXr7oNjhkld5xJRaXkzfxSrC64qZJbHfJNI+4G4DfJP5SMWxYtz3djZwaQ0ayQWoTXmVQFdpkMD5cK0y7fSsd4oB/qVQK7ZVKmpKtUnFptFYqU2mrdJ728KfSjamt215JU3vvGdHynOSnBcb9zhaoslaK4WgOuBpraiysP8bMWQ/diu7H3NbK0PFbhUy6LZURI6ysaWiWihWWytDZlTuOwclglSMgI5Vj2LfywCkrf+DU7pWfxNpaIZT2AT02JGUEXwh8kiotg/KopBe/VQhCzlNOfInek7Yq/8Lp/1RxWqs4aFUlCn2qDHw1Un1UeZxpq1ZAv0+1k9cUWPS1Ixc0PYEW05txAvt3UuGtmrP+7FNt2F4NBxH61Fl4r7NkZmOtsxFoNVs6Ukudetun4qpVoagqyoBsLHVGTgBD46PDbW9UIJ+0NhA726JYt9FJDd3QLCjAMI1u5cFLozvnilUptDeqBOcLlVS+30YV8hDYqAapLY3qwCd1NpjSRrmnFsHneprXrNxCzt9GiltpwqlKnqU9nlOC/AQoOiS31Hm4YIPeMe40TDHuRZxRpvfWyBv295hdUInyhx3zIiiPFkMUkA92ishapg8xljm/NfkCsPymF9oak55u38alZKR4b1xYafDe+Hwjdw1aCwah8Y0vKrZbGlcJtOzE1qwQjk9mABtr5lQae5ku6HDWE994xXqR+vTToNkaR0DfN+CqYu4gWOLGw85sDgQDmtCbJ/uk49akcMXKgvAgo3Q70D4NZr4JV97TmzfntUklNRQy0GoWtDfRV6qaqKzgvZzporY38QGbtDWJwsCfRMhDS5MAD0jM1FdNUpL3BkEh56XJ8GwOCPm3aV5oi23NzowDNzsRp6e12YnYUbM7ecuQbWowE82qJfFMKQbURjPNFfQUJfTzIBd8q/Djmqll1qSZhilW0Lz+NNNHAsuCS8zPxKR5ZNRnbRYDMmBviBEVC75paenANXugn77NnjcZvDRkD5Y2C8CfxcAG87pob/P+k0amzVsJdJmVxrBvg4V5zz3rkI4Dz9YEH2rOt4eB9vlSYgZtbUaxnBgpmXNkqubToP6VDjoOW5SuQV8ltQwJbQpZCVuVYB3QTLWvlMHMXVNpZ2XkVpvSHFhe6Uku2ZXhvgVtysUtZFe+KR0C5dvg8Cm35G9lu2RgIuTSFmXPWREzR8eYivXmyEMpP/xVfvy1jCpQV7So6EWryoA5VUNsfVUr8thH7cS/ho689an5md+aN6r8VfOHbw7eFTQEb6ulnlcb8hPaNWOlAMeeTEXrLJVt0fk2GvhKpwHwOVycV52PVdp0/gRgG4XsdhycITA7jiq37XZA7ostdsDts8MqOsMqD/taKRQZEzYcMjvSVWTUzB0MaKW8N/yPFafNykyTb6ekC2zn6wrYhbssoQR4X0NwL6PIdt/A+8fuW1a7Fdg2eBbQJ1avvGdYFRq2WJWHvlYtfWharA6s3dLN2q3hODhNO5wWg4b7Wsp6HkLT/hsIvUCMeTdVeczta2r+8mW6k9bTz9iti+LbzXqGADdzynyYOV22go7KX8NVETpwM8/k9Wp+EY7h95uwM5f7nSm/n+ngD0i/mzd+IXdN5tzNcTjBF1Cy6PBM1OzmgzWEVnNw+mr+o0Ef858tFhAGi5JqbLVo4HiLzifupBZdsGUE7RbxXgEsRh7lYzF4sZEzBgbGoNvUVhtDmm02hh3A2EMV/znGYk+e9pFOjtIywGTP1H8nb/Y8kgzxE/vCf09t8sk8WKeSic2tEzQooXRTXjpx8KfTTWunG5qxU6WMaO6dKuOIW6cK/+jTqdraCZeLraextr2Tpm5aOoGLO5nmE4ZOmcjYOzkdVrPyvufoBA7eOvkNRHRyQSOj/jhYQudDHGB7qk9UUqC2TpGPm3q+FyiYOqgUgD2ShtkzZuO10wMe6rDKw/akCkjQ+TQFgXpywdo5Y0idM+7bcV0BxljTj0aHzvxek8HREX9nDqv06dyxVpeBMT9Y8bUX87BvZ8duI2tXCiVqNzXWnC52Ym1/o6adXXNqr2hjICa9lTHBoZ39eTviTYz0jCcCjNwuhlzsOM6giuljQAQ6j5k++dI5ySMEnb52IR20d6E3t92llL+lwbvGxY/WLjxwcoE6weAt1NC+DQqsy+326QIqS5W/c2o1UFuq5QatY/u1i/LAuqoYVAOQAi2EpsG17+LU4NN18QxJrV1cRratSsjaJZ+tdfn9kqkrFT6yBA3XXikAXqVBanuvIK3Y3q38SU+j28luYVu3U6LZ2o1D0H+n47l1q5x4MShs4MNqlcLfbjXfOjGGenqU3SroYXW4gaZW0xp3a8lHmVd0W7ppftWtvjv7BaPV7S8g3s2xmOf2PqBmugVgCEkt1S0i84vdYqS+37sNigAT28hNnfRnKCJk657h0r075117687n3473vV13viUXcs4naZiSiaPu/FpD1Dr530nxiqPzyIcW3fmhCsZ1aegXLdJ56/56Q90tswJbd4OaREfmUdGBsBs60hChgjtLToEw5DdqjdGBE2eH253l4NzUHsyfaR3X7vMGAXy6QJcE/Nq9T1hdMNF8zz5vAe6WPm/Ff6SB+sz3H33WyMhgn5qeQJ/91WYT/EyfPt3Wfyedbvu/k2q6b/9OCtn+nexc0bJBu1PJd1NfxyW5d7HdCftXRmVmysTpghe7Od25i9OdoUenW9Fq5J6l4C7n1LBIRkWwbmof5zXTg4ZCFBt3kAkzI920r+OKI+9+kaFepwi+NYeGQ3t/nAaaz8PnHLY4J6BcGDK4OZdM8CUfYSIX0xMYcy6zQ96dz78dp4CBdueMhaLnlhhuq/NtldDOjMYX6MpY6+rc8iTcLDdXpc0ZCTLs1f3/rdhnujzOgf54vaLdObopjswxaLp9wJ6r80iE8MjM+dd5jLw17M6PFehkF4qYCiDloPTRXA6uOLvkOx2MFMLf4lJKNhny/XUpUtLpcinmJ1ZD1nzmapmiw9D7asDlovQWXK7U7JvLZd4IJfT66nn1+bpAGhJtcv/JkLhLXhazq/LzVhppoiQjfGAdwQ0TkxsC9VlTypT9BqEkx1eKqDwmq9qTHdZePGhQBqEy7vOeMx9JEsbet3C7y1894AJzkifvworvU2Ml+sB9hCmzwiF2CUoYwIT4ImDAdpdIB+vjAsJJ9Lz6uMQwx4KZwMq94rGaQyPf8LiMjOq5jHyV6jL8PcxMp/ED1bO5PAz76/JAJSUEuc7q8rNGH7eDdrfjvU+4HTMwaAXpHDdgwM50cFe3zBa5ZaaVV7dbcm6twLNlCCIXaZ1zXH+Qd4sU0MUtOkbfBxhuw/TE59Dhu9vDUYCZicdpWYLdPj5v2ny+6Q6fTZR3ny3NxeITrpPPzpvPSHfEZ6StDDqAt8i8hDMq78OCoNsabUE1wxpB9XzjW0E1swOovM8pg2qG14Mygx6UVw00oRyC6oPl8rBBXU5s180l93UqrFkpFA3TfdgW2DS3HVwzghA0xAHPyPcUqa0U6z6ktIAf0fkTMGhAOjOPFcnvexz0pqrjIMcZDsoYxxYHPXnsfKPo/I1DvMhbq5a+4DeOpDPAPTzz/d84/H08twde0eUSGRoLQyVToksUqvSNQu0vbjOUB+ALdau0RiFwXbyvwABOoSfBLAwmjpKPblEOqEQEn07aIBusKIW8Z5l2NopkpnKNIr0DEPFMJwWuuBUdNUO3e5QMjeATq11wxmKtk781CD5Om8+Lcj3zkZPf52wBb48DA9CE3yj+vqlCbQ5RwcdIKgi+mjUFIcq0CqxMB0gz8oP8ScBbs5te5KYPPkHttBy2Bp8EJkkXHDjlW9NcoOaZu92C75ksxbW8XJDvEpCJDZwV7ee9gwY3yvxjMHx0509wY7TeKGZw685LsAbW1QFVH9yt5vr/zgxDx/t8MdiP9yNnxV6eO3jPO3iwe/4OA/Oed53Ix4rB4z3KGNII5LyoMKh0QdYw86J8bhIX+S+l4U1oQ6gudlriErDDlR7KGpfoja9eaxVvThfnuOzGKlbzmhSZbAXGLs+IUbz50Nxp3rfk0GzvpxMcfdOREaW4KQ/7jUylJZC3+SHo8dfubJHpLFtTZdsWMISN1xCgYgm5K22ROSneQxCQB3akVjow0A6r9g1pfx/Wh7TXew3RN9kconeys2i6Cp8QxQr57vEbojMMuY+QTi62hAQ0mcSwit4xE11VxqBvVLv/Mlu1J1VRI/eUicbtffwSDUzwVjwfXUeTVCwZ98NBmv1FYZuv+Q1cdBPfChsGoqlB99mRSmkNO5JVMkwDRrP3vVvYSZX3sOuSgc8MBiys5sv8sMoKZWs1NSkE7/ezTyRGrM4MRASuFTpQaYnGJQyxmMgX4GGaz1gzK5X3v7D03MJ658DkDoSY5zOXMO8UCaG75HCqLfORAona8yaRwqKLY0ZANGwMpFaj00lr9Hy8EZ1y2S3yEp4dT/7wJdJ7hl7sXAQTMhC2R+db4URF5/rXCHX2fC8RnSPsHRsZ/EPXD/z9iS70jbx2pl5OpxxA9vf1CEBFaC9w5TsTm9381Rj9neCkNzZxzoRldDd9l/D8SVDA9U/u+3cK3K94/XYA5Db+ImXkz5fWGJkmjUEI4MagW3PRQa3/nacDHDHIdb4dwSDEoHxzH4MrvufeCYUDAmA42WRIQ6945y2GPImLAS8BRxl/AU/3FqVT2ojxqk9UBNHxbwzHXR4EGp5vG2PMUlIbjHlls2fYK+bBOty2mKVY2BozE6Mx75tdQIF5Y7QOAoqmvUZuZupyj9mBbvvG9HyGkLW/v0KLGdlYY77C+VA+i4yHM+YYD79a9Xlf1cbz/jAkHkYsNh7JPNYaT/6CIx75/dhpTfcQ7fkGbwcdIMo+6Hi1zMh3Mc7fQUXSx/gMMMmgKtFwDpDLFJ+qSg47bYP8lAeDntZokMt1ZX9/V/NBPm9aBkWOvz+MGfSwK+2DUoIw/PvRJ9+xDS6ZHxpc+TK17+D2vk1bBrdu6+AM0A9QQ3kdrOyYrhlL/Q7WN3y4DTbnRstgb7wP9vzhECq/jPql4hyZFkrgOfJt2cg4/8AUoXVIvrgccmdgbkg73JYhOmwd0qXYd0i32+myfYi/v+YZgiVkkAqmDSj3IWO+v8gY+awjmRQYteSz77D6OirLSGd+GIwCfYY1W4Yp1jdNk7EN64QQ5shQyS20DOsd//3AqCfPDXN/d/BhIJdFSVpahOSOKWNO67CMAg2nfPuwDfwciwkl7CwGbj4lO2qRnNlMR1Y03+hBtEhtHU7ujP6oVnLCePHq9PA2nMFq+3Bu+Wxn5NUU5HI5Zq6ImATw6vL3YdbwzJENFy1Mn+GSo5E5MdRwiPfTNwkx3NpBy3BLiAxCP9zGMCDPLd3f4fMNe4IuKW8DXm12VOmJqYl0EkqVwPGnH/np9De1OOagdczBlbZ5vI/PZynpN8xSJN9j59PHbZ4Zovpgj20iqiG2znvC+s0qEbzPOqQBV/mGd5kNVm22/JmnfXHXTBO2ToUt+U69/7AzPoTIuGKOXH+hUAlTw9DIl50Twf1pjy2zz07bdLpxRughPJqcXm2dAQOzzHymMaPIsH2m31JtmzGdxPaZwgbQhlRw8TqHdaHtoULw/x6ok4zoQoEdBr35ZFp82PZQJb1peajetj6UvxV9qNZ8FPFQtbxQPFQzkoH5mS960mCg1PzB4kMqw8I+Dyk20vE+aXlIHyi9h7o5rw85TvmQJ60f8vf3p0/+xgeKdQxaHz7tyQIsiJxXvud+OGMU68O1eBai+Kjma8+HqxVeH36Pmg91m9CShn0FEAVL+AGj9LDfdiuhkjT65FP6h91noe/DmTzJPaNIwbyIZPuHY7wrDH7PwsNfOMYM7CykJw61P3K8z3AfyXen+yPg0xtzyt/Hlo+c74PYR26poJrcygBYbnP+PAIg8gdX6Gui8n0DBODlR6zS+khnx5ee4apHMjmEXXxWnH57JIpFlhmveyR6tiIxl3ntzyOgp7y/Enok7wTbIw+Osz54rY7uX6ZsHiv4d9OeNgOuxWOVAZLVzvtjnk+htmfWQmr7j1oHwpcfqdL2o57+xi8vwWg/5k7rjy9x236cof/Pjysa+uPs9Pw14U+O9DZ+ogUtzx8i/tJR/RkUzi9jJPtvIqXktv7mZdU+v1nn8pu90/8dAHW536+2PgAA"
+
+var bip39JapaneseStored = "H4sIAAAAAAAC/1x8/24iydLlfN9fW9qSkCzN6+2rrDOboTFFuYzLdLmaYcrIXBpDYzz2xdO99IWHSe1r3Pup4kScyh7Jss7J35U/IiIjM/nf//e//+u//3P5y3+lv/zy7//z//9z+cu//9cvF8H54PrBlcEVwVXBl6kFTYNbJkYeEDwK7o/gr3pCsuAWwX8IvkSyPPgMySbBjYNrEiU+Q4ayDfbj4DPETIPrE70iayX/1yD3wRfBDUA+B5cx6+fgcqB5cO9I0AQ3ZF1N++ddxP0ouFNww8S4FrUMrp8ayoIbIMOjdMdvwRWMPAU/MuIdC1gFt+kZ2ksyjXkleg/+ikh76RD3xYl9cQruqafI96VJtcVY/efgfXDPQryTT0Ohvh/V7wfBX0pR/iq4fnA3wZ0S41VqqA7unsm01yzSD2JSBFcj5UiGeG2j08bMmLIIbsVGlNa/XsZfP7MlJYN/D74jqzjDJibaAX1MSfRLP7gRpmCfxQMVSJq3CVyfZMNCcvRiYiQDKoK7Tw29o2X94CbBvQS3SZRYlWVwA6KCaNmzRXQKrmKzpsxXBZej4HuZZgOSjzEp2KzPwZ0N+THQjB82C24Y3BtJxipnWCp99DKaNQ9uEHyOyQ4+jpv5ECPtx0YqvkqMnC3Yj4LzIIvgRuyPRXD3RE9EL0TvPUV+hC5ATLsMEWNr0IbgMf7iR3wGScOufQxuweBVnOFfrGQV3CdDlu+JsevgNGzDnthgjfWD2wV/GfwIwc/BLQzZUO0hOvvBvURhVs0bB+SVYQfOnW8MO7I5Z+sB74MbQDyCNDYVvLdp7WX2a7UtqRE8DC6zsv3Q6hPJkBqqWfZIpMXCiExKZBgzwzhaPy3JLgTlIofL4P5p3eAL60WT50Bde4rgXizYD1hUGdytrXyXWtDUlldLqmjB+FK+bJAENwhuiH4eQC0AjTDsA4qKQXAZNAjQdybNgy96ggqTn5vUeKvHLoRMgmukfQXEH4KWwU1I1lGM9+gUEA2+o5QHKYBKdM9AvvgaSSuZiIOeEp/Ll5yRrOY31cFd9wzdcJSFm+oaBDdDTw6CW1CKp8bHwWtDFpBVgkxWDWRBZcGPUMBSLQjX71lkE/f8kuiJn7UJ7proPjX0mT2wYaYd0TMH+D2429TQXXBDkBP16iD6Vlk6kgA6UguRlSAtbvtoHNwQCwZclwDIxvpQBJ7V3ZI8+DFIBqsGREWqET+wCRm1L4ekGUjf50RTZCqhX0gWEAQD1ZMReUKyYXB9LL/EyLhnKOcyBr9jBpWqIKryhiYomEGmwzC4EYTfUPqrQwUy5TLwWlxnFgxlun7A0LadHNwKwUsxLbTBz8HdoMFHHUzpFeGWhmHeQ8UMKYiGmOpE70CqoSCWhvZ9vqSeBLlm8G1wec9IxUk/VGvFLUnmmD9DG5+Pcco11tcoOBhyuuRHZiXUJDpRRqYBC5ABmzsyQXBKjNwzeIYWgTQsagDFQvI9irGRHXGRjuSvTzToGXrimAv3WshICpmDZOjoxMhnoHHwl5bAF9BRo+CuIcaQJmevFPjCnhG1hoyr1blJjDObrahR3K1lXEnJ1FN8McmGvTKl7TvCiGBdg3S9dI+ZAjTl8Mj0tgxzmX4FSdfYhroHRNM8wNgHWWAOIM1jcGugJfvvC6YTOmYtdbcrgHxDa06GT4Q0UA5dg2Je4vafoOslpXdSh9r3Iym9H9yXxEgXzOnmPTaESNO3Ken7wQ9gmmLC/LDqZdkH94AMOYNLihOQCWvAYvxAMrPGSzkwb0e2En+zmaPycYIOybCDA+oHN04wINBxY5r3JCqBM+6KSdq2XBipaVgxXg1ukD+g/Bi5jItVWZ6Z9dnF6AIeq3HocsYco2Symi2ZicCMQjvj9M2wlJHvp+AxJhxQRXQGKqAugNZM+gnZx/g8hDWsvYH6HFMw9oQ8ypL/jS1+ZB+DTNFtY9sAaM5MpvWn4Au2T22BcXB7Nuk9OP2iI5t0DO4O6MROO8lMxPh4J9paN5JZ8ANMuQwdG9wbgtmxPiPKrRZfWBt8gY3SGCoGBXUTOYsVexbP6sxUShfTsE2l9aYvubHIg/NoSc5pncMKT0TK9pG9bSTTDRg2ZCm6bQMqiBaGLEfGUjIovjz+BMTkUO15cIU0dGSk7b9b5Jno+rXStDeAumaVbMyUSadx2ClV5K9g8l4Hdx/P52tstS8spuECZ9CWG0ULaifnMv6kmt3TNVk31kDa8XOm+4Ot/ANrO6dBDNLZCrlUpnr+GvtX9tpS/rSiZXA7oBWb8cQqn1jlhrEbCDggbcbX4O6whHIxrHVon9maZ+mzBsF7CKA8uDcqlZx715x71xyWI9APNurIsBNH9Rzc8qJF/lL9el3B5rODc+YheGdNFf2SxG6bnAZlHnwf8/EakkVGVWPaJXxB8zf2NuRc0kCagdPdjxk7tjH2uU0sn9vI+gLtlvHzJRYgPqI0oXmfGm+tGouM5MsN13IBN08qaMB9MkjO4BtUD6IL6MbswT5j5nFME/wVY3TkQH4AZSy02y4U2IajjSXDpmztlM2a0ksmRLqkMNP/TKIzvwiulumW95S0fa/9IXsHd2tIdMKNLeAVa3xAPSigCe4NxiAiF8GVrHcRN3SJOY6OeUTVFuNz7EKg6gb0ERVcY4XsTbWjdviP4Gd2zwFqq5D5rptr8oYu0oJTvsAkRgclJjDr1NB3ZsiwDejs3gJ6CEkLbidudP5p33X+koKRqq1BVklwk+A8XB0T27YUJOr3BCkZrB6Z28ifuWRkA8F6a+6ygSRhZj+GeAPJEQwrtu4p8SOu+VuonNTQLYoCeYjJIiZrLF+QvQVLfyO4hJC4tbOQe87+2+A+oZMmmEAWZl2hrlygO2x4JpzmSF3DiIe36AXfJacn7jG4v1LjOt7wIKk+n/CMYqKKwqp7hI+IMW2bGTOLk63iAk4crSXlT2p8HKUUAYf2P3EWrGWF1wldW0AbLFWgmugM9JXdtuNH7diBe3TvLbQKqnmnrXEr6qRAUj0CmMgiyqx2fwklMaFndsIJM6H3a9J+kPwHKah2JjymABpaI1syIsoS80fmTHprLY5suom5KYuekc6HAF6D3MYG30R3Lq4gWcWtWsfJNnHM1qZ6S97jxp+Y5hw11Lsot3lfQNreLYPzwRUQMmVw/eC+pobeMJPvYFEiuHNTgIxiouLnzryq2g935h7tytDuK83V0U+M3DO4Roa7WM+VpucKkiaOWcC6Ls1R2VWyipM9xTGbOGYfV3qM23+KvtT7qAV+hGHozNmSXpQ7zu274NSwuKOaL7nbKqlmSdZsYxG3seAOqOQxX8ktb0nPE+boM6ayENkoyGz1Dqhiw9Xq7Qz1klZvd2iH+fsA5VHGPd/En95AJXROMgQ/QpaXMi4l0yxl46C9sYQfr6TV2zk/sSrG2G6BVEQvhmxSb6SbNyA7/E+tR07stWeILUF+iLHZs75XiFokOPBDDkzwA1oZU/7E419EnjD0qUXWbNxJyt0kSnS4/GVw18GhJ8zfWdIWLinmBGmPmVoDwlf5gTXV7CsYn30ijR3h9KVsv4LWmn6bz2XEJiCFVL4iGbPRsIBLkoz1lLD1yr8JxdKEYp/kgUVjDLvSNjF5C25L8q845hTcxCr1LNcUHEiBFftJ3eYi16dm9vRJ1NBHMjWFP9EGmpoz6COJCqZPZgAVPSPdCcqUhqxFRgdtUwghJFNvE4LHUK8I7hKcMSGmOPxD8HVcXIEjfyDdvU1hWgLpXnvKdTiNL1184tKfwimBBLBH9FuX9BR84spG8BhdMDXnz0fUhWQVi7pnP3enu4hRq2lqBkw/eI+2rDhQ6zh4zdxraVWfwZUh+a4pTXZsXQpk6va60+C+QyJO6VICekrsgL9GpjMNb5AHnM5OZRVPEjqYLwT17ZTgyQq1NTrlepzyqBYejocUPjfpnRepyGPbkCVGMPi2BhMjWc+QbpuZrLSuUqsFo2mbBcbUcbKZdU5L5kQLohPR2TKZoVFxowt02zOE+bFKlcuWCclUolX8qAqaHWjI4kbo6IoyB6hI1C1kee9gxVRUcve49YCqG9gmiZFrohXRGUk7N05lVxlKED0eRYYlbBhBJqgrKjSgnGiJBum2856mCU5/r3HKIMTEP4iWukP3V7SxKxysEb0D7fnBL3Fb91j+gsw/UlEFViI9VK5UdCLc0/1TBfeNmY5Md2TsiWHqEqponVZYKpjE90J0M1wF76xUO9Wt0JWp+Rf6MRnEZAQb9F4InOReZl204+5Z4oI2HvjEWt6SrsxP1nv+A6/OgcxiMo+LanA+DtI5xSvbCeY24v4DbHeJ6SZVS9QndQ9x0eMFovgrfqNJmFp8hS5A5COlOfg5tas2VxC0kkcdzZrto1rz9gUfzeNSkD/y6ASc07Y1IbxNK3/FU+EqdmxUdJdXsVy6N6fZh57FzOBoYErdM1XYwaAPa8gWoopok4hi06lTm+7vJ0YyBqsW+cyD8V500KP75trukxQk9/jUWjcpVo+exaGeHIu0hv8tNbRhQybolVqElYaV+I7PPLerhVRMUMP9UPOiB4jKInwKTlVGiZE1sqoEqumZqnntAk467dV9cA/ohhdcJMQ6+Ey5APIe3Ffov7ol9tkHhn2HNK05/p8pLmqxorW+k32cuYJrnEAC6bldrfeXxJipg8+Cd9JIP9bLLNrHPo++2dRlHd9CrNWUlAyz2H6fmWW4JFFjsJuQs/jaxIwH6TOz4tS/2q4+7NsTJf6SaHBhCCekAyabEJWsocamYEZfHtAEtTzypGZmEkZ95r8LL5hswpbhQG55YWSKZQ6JNAtuieMNkgfmXAb3JS7mT7Z3icUC9B5lMF8EiO6aZ7hvB/SM/cpMpt4L0Bs2rrN2orgd0Imfr3plxiUwi2/LzaSaQ4oRD86jP2Z0As3MCdRncMbgHPoIpGCwTgSUeMfgaVxiK2FJ6jhmFtf1B4MbG7mfL5fMYt/QLPYNgXzFmgDZWV+35E/Ug1a+swf+ZZPJtzvOC0OOxxVWgOlbkH7UAaKMSHT+S+lyX/x3E80f4jzj6LNlPOcYjZ4gyN9bjCX47ldBAzsG7c4oEJoT3aWGVDWDPMRELaY5Rfnc7owxWGTmPL5iNOf9qjlvcszhTwZStTU314gm/cSkFVTQnMM+RxcAzYO7SelT4ccvWWp3Vjg3wV72LOaak2Sui9otSco4pqvxEbc+5+ZLKUj+Abk3l9U4ZFs2OBycB3fAudGcLlpBNpQnLOY5F+RcLDqUL57a4IYI1nU6D97jP8gwuCy431O7UHMK3iFDbt9i/gWSSUw+xaSyzpBVZvPM/+ST+gNehdTQu3W1+VCT4JrgPMpt9Da6L0kGMVGXAsgITX7gBfXEYro0N0jTxKctDT0NQJ9woPIQXxjHan6wmyhdgXo1rOG9byZTW7Qxt8THuOoVIh/sYnQJr3nDS9Eku7iC57iMPSbmg911/qmCQ0y+xR96vDB0UkegvK9oeBUaOxnhYuwAuajffdynJoxArqwodbZwEGT+NXYHWZszwATtPJlNcCM4YARZphFP4hruPIF2QDnmVGPnolq+qpDGfHU5gqfs4ApbcQzaPW+YNbQQe3ozP9r8NyqaLCUaol3QsMYH2o0NN7QNrujDsfRg1oPOhJ+sB/CSMRVP/R7s8q8WvWKr1rz/0MgkeoEcaWgINdRdjd1COCNYr9Q33GU2NBsbXjeQi9juzpDEPoigQZ3eYbUlRmpDWpDtURpcnLdP8Bx8MTJ/FTTi9QYoo1RDTZCAaLYMFmdDJylQCcnb0CqV0WzH8ZIpH8xGwfebjQI0ss6w46mGx1ONCsMowR2Dp7j/0lDBUwU+0B5h4rlNmei8+IH3kZrYGGlojDDNhsFbm8gwS6yny0hs4NlAlOxHHHOK8pjZ+GCGxNJq9DlRkejNdtc3JN2xoNRd2Pm1JhgyQcawDFpwwdOrxEiJYJxzf06UWAnqUF3E5Vdw9y6wBeNkWdAWWPAS0YKdvaDHdSF/egV3wUNfpNEN3IKOowUPXgXJFmzB3l5QKKNB31RfWNYjm3HCOl3g5k5q6Ak6YWHr6umCLwLtuOV7So83xMgi+AFFb0q9E1x1YQQeanajbDGJzsiDi8AkXTf6DH7ZhawiraE7lVqoESsDs+Rmf2kKcYHgAdylS7usoJprqYeB0jIhXsvJmCCjJljyWoggC5swbMKwO2bvNM2yNb+snXriJEh03jK4Na2mJe55Md8GXbOUVfYP1vfMBO+4W4E3jVd0OiwpNZcywHlqG7TGEsg7IyIktWOkpfT+j9TQGUO8tJOi58RIW8KKXhmgFdE5hTyheAPJiHImUFtvxQd4JPp8FaRi8CzOoF8F8sTgHabq6m92y4qmConqnRV80QjW++ggOYXhqg0WCftFTwlFea7+dlADfgtUs6fmbAJ8GX0jUeOWdEKtbPbkiVTXbsQZ3LV5CTHyRaREBktnRQ0MdMO6nqUvrhJ75qrB7/K5OYIPOKFY4XgE9a/o+l3hAW7PUA7fFTKc4aA34vvWC6JygcZwBKyCL+DfAGpQyBcqSsR0h5Cr+BASJCe6YYJJnPrOutJ28cxQMViPDr5QF65MF+Ykaxuf6NIGyF/BX10Y+aG70y7eO7hgVjw6oS32xTbTar9+obJ7orJb8/nCU3wLYx3fwkgs8iaxmCpVJAfPCB6xxBFW4Zq3hNc0h9cwh4Ey5tC3Bmu7Rjwi0emxDq7AV63NE6/no+CqykC2DNYjuTXvOKwpT9cwpoGmkORr80hnLE617Jq6dR2/Glj/7Zo6eMGUS97uB9nGKZ9omq/l75lCfc05sI731Gs+S0ZqfeajV60whu98kbembl5TYHfKYK0XZ0WXrSGmpYTIZb2WVTVkhpJezSfb9I5SNJUXADfxY4gNj7wTIzWD1eG94ZYTaXSibOg12eDOJ9AtGrPhZRwET/HKpfU4mOTUsh8xURG5NIP9jEhUV4FscdxnxFQRSAGkV2I2lGEbPWaWSbrhTwcAnYHOltQ76dAsMaKoD6/uRmIHRG9E70Bj+3iPKz5vJC9QAdvg9NHN1rwHDYL1nHLLZbrl8ttKuhWQbkC3usjcmeSEJbHFGPwqaGpzouEB75YXHbfmT33uCVnED7WER7eacbk+I9ICHqm7t7Frahs/ANlGD0AKRtZxyllc4Iqfrc++tlQ9W7qOtnyCuw1e3zttaaFaxf6nx0ZbHRcdCl9aRbbr2nLXtY0vBW61kCjms7U+uve3jTdWWyqTbbyx2uoOyhVM8xeDT1HLxDrcxjukLT2yqah0nHheJ6b26x41PW9FfKUn6mub2qy+rzxg+ooVgTH+GryzBP7KSlS/mvoLdjyO23FZ7eB3IdKwG7xC3tn5Yj9RItYH7m39bkgW2A5WEzLhwKwGmdNNsMOmEEgv1O5shpWpBf+Be8A7OjFgnSH+HZthlHHA07UdlDfyHIMfBbcHQOQJw7/TR+KuQvAZUkR+S0Db4i9RI4I9XAY7yo6dZP+EpDBuRiQq7Xa88E2S20eb4wzoE1HVMxSPli8hg4H+yeLeoSd23JBbbtuGWaQ595/5mOFZmvkP6LZn3GRCcM49y3NwpfT4KDFSG5JhfMbZMZA+shEk0/LZnEADkAUv6jxDgQO9sNQ9JtUzp8lzO5wijZ/5OysSLFfpJNhf8UosyA5K6Zn31En0SOIZ3h4gtT5faKrtBRWphal78IW7HqAihd3NvfGer7j3po5LEj1e2scaeR9rZJBFasW/RBnMn7KnhbKnebenfukujUiYdNqeRhyCu6aW0HZ7XI4yZLEV/HYvNCokzCrU2wJ/6qmKZZoTNfzAzkmx54U0kEfeBNpjc8TgHCsJpGDu7kx0H+uoPXUUSRWTBotibw+5fouzraBhQP7JFbOPf55kzzv0QG9IIIpdZvkeJ9JY8nv54LF9j7+kt3ePPQRQ3+aa16uEewgWaYycpgT3wfrTfrBjT3fjnkeiJOxZe+PUM5Lzu14gdWzA7Vd/9rF23ce3y/Z8ypvg9Bh2LNCSCVZxQ9bWEeZKZF1/Mvg9buz/s0Hodl65RZrW3HPbRaKS9JX665VH869cKK+cZa9cHq+8RfnKHcgr9j1Aekr3asavFl6yoCm2SEAV71O80pP7Fj1b7GrQtfQq6yYzJHPoVRbNJet6YIkNm7rEQ/dXm/1VYqS6sASo9ZVVPkKov3KLlLbzHOvCj0j0geMr36Agt47rqwzqXXBnfDSs7iWbuGF1OzZ2z7A3yMrX+KLaK+90vaoitrJOzHfGYnnlHAC6tw/3fdjaQDWQ2lVvqAkT6dV+TGSTGME4yvWGVFHXNHnP1VNkGxnEjG2O+DxGAyKtL4euASm5aXu1E9Elib4Yeo1vJLzSHE3gf4diQPCfyPDG1ZIEd+D1qYMeekqGQ/ym48DbT73g/rLXh3pui8g1SxsEdwDSdXSg9+9AhXOg0/0QXIG7xT0jnSPqwNsxB7puDsFVMHoPMqJnoBrGyIHud6AbID34eNcjKhnEA5XNIT42PsAhTJQhAXTOMjGSM1jX9juv/x5ofAPppz8zds9ufY2r/cakJ6KzIVMAB95qOHC5HWQ6loa0ap9ZNbAxpcnv/FGGxMgN0+ip+rsdHOWM0f3Ne3yhCqcMOnm+0ctLohP+G+v+xntWJDq6IFMGqz75xnNvppkzWI8/v8W/uvCN59iWwRdAeXBjIN1YfdcNuM8vjPBmJFLaE75v8dPL739Ti9/NxVgxpR75fov3gt9hVyfBHXl0daQtCMWlm5OjEPWAH+N3v8f40ZIQu8RzjN8pHbnyjlx5R24ljvFVmx/cVBy55o6QMoYsVkfqSPP9yEPnI18aHSmHjvGrySMX5VE+pkMFkF7HPkJl9WSKLSgIehLz03MD8GlqqOHHrbnfOdJ/c+RG/0ijHWiSGtK5f4yvQ8FttIXh0r33AdK9y4nHNSf6LU94rQSkxxcnUbAOqMZXnESvZkmsE0/yCZpuA0v7JLv0CdCOedV1eOIBzin4AXbmJ6qzk2gU3X5g31onseI88acJT5ANqaEcXXLiJRsSvdF3xj0MIL3Df+bsO1POnOGAMiRT6szJdaYmO9svPvQRrDsfoK7UGZG+TDmbsdSRCdOsWMiaE+Isn7C+MIQGTplStuqWUg9Zz9zpIvhorbf9fXfUceZD1XPs3D/zh0XOvIl4Zucm8B9Iz/pLXDgy5EsgdE23MVA0JKpSe2eG3++xO/hAEL0tmmJv3BNS8ddTE+MvSKZ3xy3Ya4NqVl4HVxK9G/IOaMaaZ6yZ2yh/Gf24kb9EPwvyfdHq/tImcYuu7Mm/v4xMrpbAkNCTax+TQUyGMcEmR0lufknlMDSUVAyGFFYC5wmIPkRTUrDom+gX7LwTI6cGqoJrkOYzL14j5kGkhXcQPoL0lx+900XpP5JARyt5Et+V/rCkvseRJ0oa9GS/VdiOmLcF5T32+akhPGMD6Q4a9CehcuZ+stzq1GoRlJYi/PKo79ubLd/nVOzbWlNUMWktuyNFjRnInSHt+5xV9H94nv96XH8rgXAZUtGfIux8Hz/bK0h/rMHjklkZNcMPrRkev9eg626AfcGvht5CZwwUjP+eKtJnLn5gdrsf8HYVgnFFww/4KWJZuwxJ6cRryReRbn5AHzeC38TC8QN73uP5m4Q6dn2irGeIh4bK74lqpuENGru5mUQ/PNoiXXb204CuT/KDZU8YfBc3a8qsVdySeYwKIJw4+CHkOFr3hPMzpoG954f2WtYPMQBShc/hsCLRUb6ChEgFFXC7JEbuGAztreShp6vVntMk9gJGkcrxK07IK37Blbni5KosKhuZSeFH/D0kElz3bEmBH/VIjGghE4bBNvMjjAPQnGHamSM7gfUj+HUT82sOevwlHVPZfkTZO+KP7ZDAFlZS2Kt85WyMH7GosX2vCZCR3WKTTQonShbL38xeYihSYZfZg5W2CZleIJJ1mkISc5KCXDN4hhk0jn7ErWQkTveV6Axumw3RCIRDfb3u52MyiQmsGSVTq/SnZ9LKYVSBqG9BHXgZ0V1qCG9qlcyZup28ic3zJRLc46dOU53xJnJyTtacsyG3DaXnbyO1qJQ9tqI/U0PvTIr9DH7819WGvEsNwcuuRBd/YYZ2i+7xk9o9IXX0K8QqdQcJH6P/zwCV2UsO9F4AAA=="
+
+var bip39CzechStored = "H4sIAAAAAAAC/yy72870II8u+K9WApFmrmjuxgmuCgXByGz0JSfTV9699DjvSTnswXsb6v/5///r//zX//73f/7P//uf//zP//f93//+z/9s/9loDzHRwZ52PjiQo6DcyNFXufFCCU2py0y0UOafp5zklLzRVUengx0VKtQ8lTByoo0KpyCdHJXEBnq06pFlUl9Jj5M2UvlSwej2odw3arF1LvioUenglVq/aKPWVYpcK/VMbaOeGdMt1CV7Gl320RZ67rzutCdyOx2n6LrTEbPf6cCW/E6BOme305c7LTt90eMssu2UOmtkt1OmgJ6Zu02TkxQUpXBCcVJZd8oP2q59tG2nkuSi7nbSnTChshUuKgCSBHUTk3bWROtOXU6308wFlQ8BXTs9MUdb8hnoxKHgVzELN1Bk59Yjrzt3bImfWNCdn0sOgE7J7fG4U173GLK4PX46Nh1TLLffYwai/R7lq/Rxe5Qs33WPfdK6x0fKtmc6Zb87PrqM43R75uMkv2cOI/O6Z27J7dhnB3gKbXuWBIL6PcsIsS97HmHdZee07LKr3yXkiJNKKDL6tstJXVp3u/ySdL/L7x0vSfRubpfMra+7KB/bLiozHrzsYKVdxs7Z79gbocsIhN/MaFKr+se67UqBJrCqdO2i5HalAqwqdXW7cu3kd43J+EBBIs1yrrtKefyu0hLPbddRuNs0o8V3/BMw4Ln9PnYca9vHXrB/t4/jxKxAAud1HwHkGUGA3/Hhvu3jRyqt+30kHMrtIwdJ6z6y9SnBfpOu+9AH3z2idUp2+3gkk9vvkMFe94vS2wTS7c9I0t1BlbT7g5RnoeXgoO54KXfw7ziXg39lOTjTenBmNObElz84F2zn4IJZDy4Fuzy4YHbAruNC8yO6Hdy61NjWA7jxx0l5VNqOkyqFLKjQ2GkFiVDNQEt3x8lXZH+c8TilA+rQ73qcmYI7zsw75spjj309zouzO85rKLnjlN0GyHEKaiVYV8msBi8ZmF5qtF4qOy3HKdMfp1IFXk7NsS/HqZhaR92Os8dpZzxHsDOfoxdCM1jxOGfMCUuj03qct614t/cgd4/dHXEfmbcjfkm5kz/iybnQesQzkztiMYpETaNtB5SaFlSbqjxi19H8EZ9Y+HBHfGyZLNiJJMlxukMGuOro4CZ39JckIwC6YxjnHiPpeowqfTsmJYjXckzKyzFjcsfUhJY75dg6uUCZk7hAyjutpgrxO641HKzkAu+gWGAgL5EPfESIW+BPPGIHzHTwEjhlFzgp9y3wJUkJjRWGYg2s+70GbgkzdU49zi3EJLvSg49u2j9ECeRCrFmuJcSWfIitwq6EOMFGLsQpx7mGTCe5kGWcsoU8QOa2hMJtDbKr+ACNxckFAZ9uQUDK2F0QdHJBzswBQAKtQU7F748vH+RnXB8kgQUD8E4AXXQLksbFpa9BMqkLknmiEWK3BLloC3LFQrbilceM6HrJ9EEK1l+DVAr4jc0HqbkAg1KljbAFqSpT7GO0bi1Kj+0aCmIJopih0emCtCxzCwLm44Tagd22u+MknR78ctqCdOMAF2R8QBExlvZBpjx2zofCAHhrH9GDfVA6pdMSlNIWlC7qMbmgNPlYg9LDa9AgeQsqO3gPH1/WiHp5gg/aUL0GNayrsXIYO5ctDHBSkTWMwGkJ4yQfhjHXGkYDkkYr4sKAPfBhys8IMqH6fbgLXbE7TjBUGyfY/8gbZ05dpTjOsTZa+aKYV75i45UvOdjzValH9lzjAzbjKsdpoPJv4Spt4cY/x41hEbkl0U6OW7ov8dzNLHgeH1HMMiUPdPvH6YVp6MMb/6scolXULA87/ldFu+d/XSn19UNHIveh3yeR/1AauQMWIHj7UOn0RN4+pBcdkf2Hpmjs7kOPZN4+HFjpYP/hHwz++mGY+080b+ETv0OpdP+JWR75rJ+Yu/oPOPLg9RNLR99/hMJPNLhPpsJ5/eSo3X+y9Jhp+UgJ7iPtI+o+0nfK60d6IvxKcR+ldLD7KKTZfVRKp/UzEqn7jGJN9xMTuS88QnZfwp62LxfgkNyXjXLfiOUK+W+mZyit38y/vn4l87V9JX9MO33FHDr4JttX6ZKPFP9V+Iu8fJXr8tWYl69iRpWOPS3fcZE7CWRYTgq6nJTJn5SBrPWksqNUzFydpB+UtI6CQVOp+JP3BIV68i9RXk/+FfEn/zq8t5NTJ11Pzhf5ky/qcq0nKx/4LbSe3LKsJz/p3s7YumhMGwwaWBwfLc50r2emSQ56iPpyZi7bmSVAwpYzy4nSqBCbM3fq3oxgwXjIT6flvKiv5/V+3s9yltj8WeQXp6wnZN6fYt7IClv5W2Aq3SkhlhsgY1J4X50Azds65Wdie0rinz+h2IAxye/v2B0Ma2Z/Sol9p+2UIuaQn6LAlGhApcJzxDSaBMUcJx/uFL2k+FO02Emg1ZJUVLQ+xZ/SqgTspvW3Q5f5bmfstJwyPu6UUakDNNvTFHWnEoz/qQTVDTiNekoPZlP4jsmdGgwXCjtyarCJtViL7Akd5aJAy6mCwTICATxc3KmtW4+xvzPfD/XthD6Djjrh7Y2+niPstJ4jSV/Ocak7oc/In6N16bSeE7rxvPd31B1MWs/7Gxk7uy8c+m6dNSYXww20x7NwWGOSQmvM42Efr1EwaywfRgAWi1lgB1o18bHIpIO3WFpF+xZLZy3ct1gm5RgIHwy+9LE8rBiqUiK7H+05ifvRcXZyP4J19D9KV8xohEz4H2UBMX9UOqn/kV6kaYEPu/6oUcFvwXdPtP5oiq4/eu7kfxzg2Kw/Dpm3H4dicdePz4xRnKSvP85c3I9z7OJ/fBk3/rjI5X5cQd0fN+6s649n7O7HT8Bm+GGsHwsl/Ibb/WIBv/9iS0r+F40C2y92BZOsv/hM2n4Xv276T75D+/ob2sknsnBtTQRHJ9EeC3eX6DgLrYkCd58oIJpaE51U1kQ/Si7Rb3R0SCQuUeqjbYkyzBXmzKf02yXKcVcUzWVLdLHSlugy/25NdBV0LhFqN1ERdolKF90SVYI0u0SVM8bU2CVjwZrsN6NvhUJIVLEG3AjreO+kmFbpshOp9ISJtEtZEjW0dcryBeSgmG4cjN8HbZMyHz7RQz8b97DN+5i7vKXAf7sP3OKWOGRIxpL4qltipQs6PMUiLoFTk0+Z4MktKdOFuioddS3iIJlGWVLmw6XMZSeASn1LmZs5synHsKYcLzTFBoRl2WUkl7Ik0CNLxUQy9i3lscsE2vJoNst4wMhLuri4BOL0NV3SdU0lnrSkgs0IZXBbkt00ezINkaBPT3JJ9jsDHDLUJTmhbJP8uFjXJM0nSZ1/2SXJVBsAB4zI8eElSRaf5KISxCW5GGwjV0yoLNTR8RI1UKltSV6RX5MUwkrl4GoQNg61oI6UDzdsuSA745JY3JakpKHYUOmU+pYEMj/RUOndb40YVS0OT+aEgsiiO+ctibL5vkn0AsvjQ2rniR51YB2F6USY3nEafSAf0qiDgtI6aCodfCc9sx2uywBqRgJBZFTONsNonACejIkmjFYSMCDcUjl9UtrtwEqHlSDwW1Ky5BE+mnnWSWkaFpVD7D4p/w5SFNtOKE7bhkX6AD2mNWl82CdNsMA+6cXGgXrFKS6pHFSWpJJ8gq4EglRqxHBYJICJg2rntCUdp0W9SYcpraSvM5t0xgJG0hvBrUt6127F1ikvSe++pREyFQjM+KhP47XIaaRMPo1MP/DRyPE412QJrDQyWAiwD8U4IGVUCXGOJQ3tDr6p6JpGj9knWAHI76T27ubPGqS7mGuW7savprk7qQHD+t3RGdkFYOi2iGzLtCsFUZ/NahSfyaLRJVNMPhPY+3AZiofWTFcln8lSCA4Cf/CSYbUyNWzeZ+rYk8s0Ya0z7yjwkcK9Zg5cfOZgZj6zOVSA6Oozf7kEDPlGtH7NL8l8Wt/TjFfm1zHKnPok3TLDSEjAR4/ZZqtcXghu92BYKPrMnQ/8XrJl7gIS+8zzJO1bhi0iW2fKLmmFjqctW1IDk0UEl2HLMTTsY8vxlHfT8WcxVY6Zk8/xYohLjiXyas7XlmORzOPaEK5LpeBy7O9QC5p9lj22Tj6D3hJclm/EmvKVijUlGbnXLIn7luU6MMxBRXYCGMe5ZNGwZmnSlixdHbJ2lBcE2GuGnPpsHldfzfpvuf2lShAEKXYzjvhhXfK46pJHS1serb8ksFRdvpVcvhWik+8G4l4U6MJvFn/RN7au/qKTvlL8RT/unABj57xd9BNLIF2UKG0XJcsA+ouSgrMuyju5yyykv16FAPjX6TKmgsLNvF5UElZUM8EXNUoKkKRvF1TIsOr+Du3Y8nZRH1jfX/RAbbrLrB9AjljpGYoVQpadtouPk0pM2wUFRAfjwyjuIAfNgIyyIZtg3vXFPaO2S6Dl4q7ugk9D/orGyP6Kxrruiicym1dM0H1XTGoxEVy0wsd2RWMidVfMdrp42fZjOWAF/RXhWigglAYaBjquV2zYxkuE+O/FXya4ycuVT9qu/PLscmVJ65UbdfeX47hKPE53FTn5Wi/ZY/aXHObkXhI4YwMScuwgkZw36CVJZUMsAZ22XQJMWL9idwDukiIpo9yQZ8SH8TsanlHWSxo97pKW7r5cEKpLepyGKulixJB+GxgH9i8j6LulhyLOKg8n/Ep2l9Ilul1K04z4pYmnv7RPwWZh07ZLn1eLX83Sb+s1gh7uGp8sZb1Gpr5e44rsYK0PXq/RuLvrvQi5xgMpvsYTE5W+XXezqGi5nkBboTdVvRVCJpG6KxTAN4XCm8kqFE55fCFLcKFYY3OFEE8CFBuM8C5j1KkE01coztgxP/xtGb6QucKAyJBuhVKWYj1fN6IQbB7WhzLzhcDw6PfSeitkjKNrIUnYh1RKvpC0ruheKRBmqzyLlfM7++teFKrSMgd8gBjooRJsPR37vRUy04j69neCxslmbJkrFmzdil1yxMIgGNAz9Ti3QvMJorcr9EzqS+GdXWG7GCh8pHsr/JfxLBx2qw3jdIW/UFWFT+6usKUFC/+4+MK/qhOTwO3cCueIGHAr/ErYWpBe2gqLheWusMB+FK6S5OcKW+qusE5fuIHqW+EmI1NwhTvi18JDpbjCEK+t8DPvlNmVeEintcQfpbXERBd+w72UmPJWYuqs1tLBD3JkPrciJ0HlbcUSkQcvRZTWIsrJF2ngW0DzKYqMh12BVN8Atn15gtJSBhAwoC3L+HJfLX3py7CMsyuja+S13NeHFtkpe9lJoQpkp0mr7CHORXY+vOxsvCk7G8eh4gFLyn7SD8oS+gLyssmO0DF2J/uPE4bAkbdypoa5EcfDB98JQE4D2KplITexWyQbID9Oq+zvgkoPH6vsWjBAx44BerdV9kanl73lcWIjrZNSX2Qfc5V90uNln7HYuaYElECaTfZ7WqZc9kd0kYPaIgdnJweX2DexCwxsxJjCy3EqFdrkiN0yBRJ2+eH37k4CXBAvAZn/7iXwrmSwZSAu8HwCoR8/k7yEk8LBgBCcVcKP0fsXywirhETPJgFetTXi1kBCHomchAuqWIJY8LlKqIQRcJkl1PxurIpuEv4y0BLqnWwzSo+NbXaf5yS0PuoqoY2ySuiS8DuCkzAJdAmTcfAw5T3EVDoY455CaRUkJZ18Gv3CKtBhXpDASuIEqou8IPWCHSDZwmmRRNVLYsvOS8oIKSUV2cQCBpw1ybydJKVGqyTEQJK0gQ/MW5M0KpXuJJmpljQaSJX5Z+kohGYCnSEXJS/w/zs5ucCtm8AUWq8rv1cwlt+nRa47Oyl8hnuTSnbp6aRS4+SlWrJ2FYsBpJq+2BChoNcqVZS8VHneZqUZhpOq0qMX3WOnvIkeJ7euq+g3shNFWLqKZrCPGgs2CrRJO86XsyyIlgbnRFomcAw0J2jWTBfb1YEVi0BWm0CcmmRrq5QhK61z8dDpRszWRzBwH6eXhpR899K5Gt57bGmRLtVJ1xPc0tVUo3SVhMqx36tA13mZZDG8TNJFJrdNZo7TRGzKwYv8i8HJE6AZKkFxc/aVjsil+0oBxtxXOkU5LZUSKjPB8bedu0oZR6+Ux06+0vXJjD5XBkkqFcuYVypAU6VS0KmYu1OptD5lA5fIJ7OvVDUmdFBL/lezVbxW0o45dNhUeu8AjRO23F6LW6l1ztbe3613lYLilIGNPIXPvlV6ZCintfKBlTjQV76u8q9ZZcriK2fpUnzlQhnb4BLeIeXhrbJCbupSWWWrYBY4KZU7aSBXuUO3Vu4KVvewy5C9yv+4yVYjldg6LTWetFWEI2CwGmGBakwlJlejedU1mtoAtLizInsIPMRqyIntpVVsXYC82HnasGnxWo0TRN9qpoMLxmVK1AEuLr5mKgy8QdXHZM09dpQnIrGlZnrWmhnkz4zEnasI22ipmRvquhE+8+Tsao6QMfM+lpqtsxwn+Yo4CefPcu20mtZbah5pqfkuvsKAxb5WXCC6atHhWqG3typHh8e2VgmEYniVfZXAtYF/JCCd7y3yjlZOmQI6XAS7ViUYPgXcjeYqSigqPejVOnUrI46rEp54uSr8RPZVTirgLznL6MC+WPIbUOWitcp5765KLECiwMuzXq/RrZLsJrFKytzW1zepkiCfVdLomCDZ+RFfY70cd05blfzeH1aBvUX9jWxslYvy2CrcjLcZtmWtgncnVa5mu4C7w8DQ64L5KkXUTlHGy6Vi/uJapYJ1oBshpQImObcKZdhs27W9vQbQqzCAaxV9f8Xq4OSjdE9fxXzHtUpjILLFDLS2JDhQA6cd+DBTht5V8gDsNHHENgLES9pd1yqdTlelJwJ2euaGDXa5bKNdaWLuPipGQsehOBKYVUYXX2U8QcVVmWQIBDujz8yERaY8KCj4WiZYAJU3djqR3EY1fDIc6+HLaPIUu/Wt8oi6KnB1t6p0vGpM6RS9UZFeSVIyCVGSjoMrVTH9odTYmd0hXzWWI9a1KoxqVbEnK/iAtIqrKgHqQwVZRhRPxSgxV62qZLmATBWEoqgo9iCoqiUtqorxmkqDstRXoamMzlY7IQHaEk3bMMzPVnUES9VWHX2FF562qlNwOlebheG1iQkLgsTT105Hn+Lr2FmhGZDbqCNkX8eb2KsjWWqrjqSZlzpyX+u4KvqWY62jclnqaOTr+7rHmycEPTbe+LOOfoOFb6UrBlrq3dJab5hipYOTU7K3LEoh0sGAFg0qBSmL0qevSt/9dkqJO21qqYxCTqEHZVO6KozcqlQCOyXMBxA7eaUms9Cq1LpuSt2eRXmlx55xKT2Wo1Wmr21W2S5rlQ+ufVMOZMlc5Q8rF7R/Mv9zihvO5JVTpoucchINADrQ52/M35M+5RkfXpXnRZvylDxZvbKllTczRrZ0fGKSTWW3O9xNJQhy7l7lvexX6CKnksS6XVS4i1eBSARASLRTUf61VeGgbNAZIN+m0t9UBRipMrr3UQIacPWQMA73pIvKqKvKUNRYyk3F7JFTeXbBBA90PIrQ6ioPP+X9OKHh0cH4QOXBbRM6JnpQtABE5blIASphGw8UEYqNDHRJq8ozLhTsYGO3jBL6WQZVwZqwkDqSvWOBP+H13iXL3N5wuPVVb+TJ9Lb3VE7vbjxzP1CjUHet09ropL40SrI2uvjYGl3RgodGl3TyjUrsibZGGuxUjRq9sDOcuUbPjubHHO2lAUXtOC2/3vh9MOcaH9zYNw52gMYh2rOYxthas4DPtzfec40TK62NE9bnBDIBTvmxa2bIlwZr3lhnbL7xG/g3bqcoFoPeBsg4B7dus7Ux0eWuhHX7jo6GksY92fIWaQHqtD6T1TUQ91qb3Wy2U9FlbREZtPZGoWuLCnxEFajYFnVU32IfdPDWEn2oBMVHBqP7lqg8XNaWCIslrj2mtaV4kG+w/sBmyn/YTFnWhhuotSV43TgZYbAoptJYOy0t6eNbGtVOk+wuu6WphXzLtIN3Wn7vH1FRYqG1ZWod1dNe4zRLjgNdmSsfa8s8MZofJPBajvvaTI4anmva1jJQkGVUHDrLlLXhpcFqobFreZSDAWrCBA/5dpHSN/h2cSdMdcVs1/jtDZGWdsl3bZdSWNqlybVLO5jnGoZY+AZLKxTWVuikpRXZXYO2oLWZq9bEMvxrkyR5bVLtu09yTcYuP4AjdgAIapNhybA3uYLipZS2JqOakW4y4A40WGWrnrFx3xDxQKfiw/IjS6uxrQ2Op2tw7tLW6t/l3usptCr26LFVsRwyKkbr5FpV2/gbPbsGFU5LU3rWpgFoVhBLkWhrUERgTIXJadr60nTsvnV7xrC1bs9CCRVmxlunCZ7pfEJaOj/g045wHrWSgdUu1QpaBG32WMLy8OfaunLbWtc3UGxd5ULbAJv1EYCCPuorBH1KXlq/k2/jzca0sXeVersGxaWuDXtC0UbCbiDVHUAGumpM1Akfb864TTq3Nsk4ZG2Tu7o2ycR20sPJtRlbZoDOyTfcowTa2hRjdNemKMg/lXbfbvMT1nYn6Wu7C0bcReCcm0bw7a520dJufZ+QNNxpuXZ31Hba35fcnfBc03U6ZRTfyV4cuE6/zyhrp9+X8Bs7mozQ/c+l6nSdNvCqUrZOxVyvtZtP0qkkVtepcqet03xf0HZ6qHPeOh+nRZ/dsgad0zAHo3NmKKKOTWAgl2BP0TpbiLZ2LqIeUbdYqRZyneEX+c5KNfLWWZESR/2/HvPa43HK1i1AjM332Efm5HuigBv9nkx9uJ6pJvLm3FJ3PQ8gtudRael4MtCFMnfyXao9CepSJa9d9JG1CzREl2Ezqr27AkxddO1wZ/DbaOtKk/Uh15U/sa/gx44CZKnraVd+vutpHlpXOc7hu77v/7q+54KBp7VrtUWqJTS7GrUdEg18bB2mNceOjzNDu3UdbTU7sPQRbt/He5nSx2njRglKvg8Fnl0fWui39fHw1ZK4PknldH2K7uT7tLdDS5/6LP3+6trvxNOPXTDZOnZ5eB27Ut/GrnapjeJo27Cn2YUWuCbbON47NDfecHH85ezH+zTGD8RwsbvxZupH+uMfeLChoONf6h7EsgkScNVXXA4zfju5YbmebVzvda0bhaxc4kf0InxYfLeNSuHvI1NHXDsQEMOEDgQy2BD0aOzLUCpu2NPUDSr1Hdbie+k2oOetpRC26UZ7Z/nLHI7WM1DTur4DIX0QnmF2e/Q8EEiMLn/NUu1ma4D0CAaH3V/7MSXbNqc89rJuPOFvfw9nPx6TOofcYKF1wKlbJ+4W3aQMz3ISruCWSQXfJVD2k0rMidZJSsVP0pPKDWjGdB6c01in5QSmPeGfHFS2yV/udPA6+XfwNjnb3x785MxdT0B7rz05X3KgaCmjySXJ9JPNTKyQDfaTG+dEgOmvbOmTybBFY53cOm2TOyukZfJkRfe4W65xxuPUbcbAciq5Ge3RG0JOXmbMqDEF/9q+GTtwSQs8ej/jEztODz93nSlTWGYazc1Mv0TLzJTw3fhYp90OzWz36gtSeH7m958BM5vZ2WYZphWXWUZaJtT5tDvq1vGBIDb5KcFybvAGS0v3OuUHesgPkjclU+nrlLwTfqN9F3Rv6V1MLOLxxgQYCB4VBzPBh3uTzstUOtephIPqThuCbTOXU0/QVVuf5KZCObpp7uc2zXpiQRjFZfZY/bwtOeTmbdI979cxXucd3l8lP++PgP3n/T7t9PN+3xzM2/L1aHjlfN7n2K3ivfZY541M/rxfeZ/3e/vh5/0++5l3tpuweduVHCA/1n5F3E/O+y/dMu+/dMu8LQYHtOubeVu2ZZv3X3Jt3q9I4+NN+M/7let5v+6Jm7dOG/KXNZ53kyQo97+Rr9H3835N77w7NZupc7ORXf8Wm/GvJ+hqUE/O27yR7rW5Xyl9KAw/nzcDMZ/3Xxyr3ey5+eAB+TafcL9nfBgE2uYDfPO1TgvP5pPvZHOVmNx87AJsPlWG0jqfDoZ4Oif/7864QHcPGU0fev9Msz30Etc/FEgvAbQLg4c+9nDJP3RmoOyxW1eDSoG2h85Xbz70o87H+tDPJnz1+fbQ34O2h95bGv+QJej9Qy9FHwvW0OGPovbk4LQ1jaLbQy8F/UPI9XX3kKUgH3rJ99AfeR76I89D5slssCFXeT9ezf/Qa2H8Q69mfcgUF2Asb9no9dDUd1vPhOFxzysOD4zb2J73b00o38DsYxet5B7gjzaEoXbt9EAHuJfkK1Jjv/UJM57bE257DLI9nE15rw9fVNzDFzT5w7Vjdjb2ePgxx/exG7/tOXN/T4OH0pJoe+z5MLaHZ7u0PPEi/8TLtOnzMyT4xxIegDJeqNQxOI3LXMwnUxf3ZD6tgH8RPVkufLbunyy4rN2ei6qpOssFPJdl554r/tg9Jpwe/8ezBS8JxmeXmmHanuvNKy2mDp9CmHhDlMqQhafIHNtjd5gYLv1FOdIcyT8yPpTZP5bV7ttjaVm0W8izPXbJjnGWS6P1qTrH+igFco+S7UiPF+laYocyf7SIe0z8t8fSD5hZn/dV9QOXkvzzJ9TP2F8P/xm7bs98vQH3wLYN/5g1owX8sj7TDj5HkilhAeL+7wCIDZIEdjoAAA=="
+
+var bip39KoreanStored = "H4sIAAAAAAAC/0S78XLiupb/e2b+cF3XzSvdZ7sQTLYDTgd228EQ2y12IIYcZ1qAIfZp8zvvYy29wsyvvl/Re6qo+siyLMvS0lpLS+L//f//8z/+838G//iPu3/847//v+n/DP7x3//PP7xeD/rjHjDDmLjPiMmW+J77wLaWdseMz4DQfEZGQ2JSo5SsavOkmZEHzMhr853VSTF2YK2iEmJ9IN6uXq+Hkm6IYub1OnCNCsxlAEjwSfDxQNQcsBFKhub7gWjRsFBGj8R77vV61ush0aBVM3mIfCBMJTgwg++aiUr8Xs+luBVMzP2OmLbEMz4mMWpri5AZpwFxQeWJ3Fe4LWFqfjwylQRSDph6nZnngIXylFAZscZrlpKj27L+XJl31JGZ+9S8Vx5S7N/MnPB0JoFyOBB54/c67+uBqKHXH7RMN0SyJlYNUX4H7J+h1x8O7PFD3WvCVB0gwYZAaw61XHCvkTzy+uNA7ivAPii/P0Yyzdjbx0iKwOuPO77kWNmk9vqj7k8dcf4EzFoBEuTEpCWmFYF2HLVN8cC1PwUOikDnH69GbX3gdO7RwceraQNkyGjIzjxeJWW+rFhefrgrdO3xKm+sWbS716aAfdkSi9TrT0F/WgNmuiOKASD3mnh4IpIroUKiOQN2hXuq17kPnCujNsxAM0+qbx7v+pMy3w82WcqIhUxVy1ThrvnZIUNe5pLXyKAsn5SogCjneFguJSbXG1qm7MOZQONPa5nmXn/amY+93592UmbsrtPOxqjg3OsbMgKz53Tuz5poHh14ZZ6XPlDV8jpjBubY6cwRP53de86yGDvwmVtJ1+azlHyJHFjENfNsn/m4TT5Ru81qyTuvP3XmA03pbleuho5icOpssvT6etDrnIA01IO+ngNmPCOKiFA7ArOjHsjkTEzdlWJJDnk9749XAjJVz/m+ei5vnd/XtVln8jrw+/OjFJjMfn+eoTVB5vXnmaka4nQGOKnOM3mZE9maQHXnmZRDgF9+ntllSryGXn+u+H7M6IDYPxEnd3UhODOAA/HAIrL4JKAoz5UcP32i5hw/f/Z6QGAMz5/mviberwSm8/nT/CQkTIkXFpFlQrhaOEnOn3YVef1Zmwh1ag78WVOtXIb9V8vCl4idfYkkCwAbVl7/NeAc/xpQqr8yjuNXJu3S67+UTXHVmHtFjLbEJPWBH5W5T5nx1hLvfzh0d/1XIyqTIJOkQ1n7oMw69/qvlmPx1VLvfnX9QRPnGWCKlFhnRFUTpwagvH51HLWvTo6p1zcDiXdEMfP7JqTm1h5SEJUmNOMA+WYfG8hVE1KfNqEsNPJlFVOGm5DKvXkSlbCjGs251+i+HgAU3EabydYHnsdsb6PNfEvgyxpN+Wi0OSYEmt1oykejZbjDoxIuOTRIKeLlTGBqNlrSHbGqiVe+5NYi2bAptNmN07aNpv5qamqDpqYoNw21UTswegtIm9717cA+V7+ncvtk5s4stplp137f7sy0kjxCSh6ivgm9/teTlKHfd58mGrDsdSTFNx/m7PsGVg6pYoAZh9SukXLgIVV1yJB2Z3SKDJsDS1lcPXNPI2juFXSauVcYPqDE1c6MBz4QDTBtkSo3AMYUKGaA/daglFPInrn/hJdg7g+mxO3ajHFVy2pP5J1nRgGLjAIZhZ4Z/YG5ZUaP5nlJ7GtC730zghLpT8o3wdaO4Ot4JviSsvHMuIGSBYoBHBKk1BaQHLdbGFlA7wC7jD0z7jDfAHzPuINZMw+u2oen/lwBZsorzHTgMgAgD0CxJerIMw9/stfD0Dwv2RVhyA8JQ3lqPfNYyyrxzGRm7iPPTOYmGvhmskVhNfSQQmunG37ndAOjFRyQIXl0R9TyUttJgzz78uiZ6Q7am9gB5nlMlK+AhBnxUhMZS0JtA0eXeYkB+60h/nzyzLTqDwPA/KgINoq+kpl+0uJqz0xruGmA5pWsYqLAS85w1cyUhstM2/6r8820NZeg1wFSstDQLUgVWx/S+5WZj50Hsbr/L2SgMSiB1JkZz4FMM2YUZ+LHI7Fdek4aWeoywGQ2ETUlkM8JNCQaiHL3lMvcOBzGxCVz2KMi+3A2qkWGTZZ3RGZ+dv35k3eTPewUUmnim6e9vNTsnyctOQbIN88BKkMnPwfmKSHmc6LMcVuCDJqL31URr+6qGPM2vWKm1IBdgVRAbFiPYJo+j3s9A2CTzPMYNhXIOx/QV1FD3zwv7TKWw9g3f36Zjx3suPnzS+61b+JGkoBSW4YmDAwmYxmaSUpMW+bnnX3YMEPtmFG5zy1Dua+oVMpQkusdsIpNsJH3kHl54BCxbF7bYcWMzZwZ5cCUj3xKV1iR3GqCRJWhzWPflI/9oeEMLR9hfU35iMWPKSMsFoD5DJCgIZIrwa+IKOplhPWOKSNO9/IbZ3ZJ3WrKmcR45cyG+JoUErTOfFPm8jqDR2HKDZVVuYFxAjAZyo05hr4pNzLZyC9Ut3Fv3FDOyi28YbNr+apdJ2pA4Ov29KXMPuYI7TWUATO0tMoz+5qqZt/AgzYfYzjLAETxYyxpSOS8J8pdtZ+ATVBy1zehbz52ZhraQCPDPDXEd0UcY++3AHzs4GQBRYBnZK3ZlI+dlHNk2MchBeNjz/VJ7ZmPPcyo+dhj8QU8RO6RvYQ7AnrsY2/nmWc+NFwF89GYcuMDVd0fI2QIBvKjgQcGlN8BeHXmo5Vp5qMk3UymLkO7jJEyTzF1GFIb4v0bIJMdsfjD4ZNY7fGMrHV/qJkqB7RoVS3HpWeqhrq9asz3nDgmAOdPBb8IgKMMQHKqDr4gcKiJo/aBmz6sOrNWyDDvlXuso7WpOilDArrgZ0MN8rMxcSwvNVKYQRCen42EiliwhKycjvnZUDH8dDbsZ2OjMZHimQ4+kPnZUcR/dpS7n//mfNMDt6S5Mxoyh0cC7Rs9Nzo2061vdOymHFPnykxDpOAe3KceUpPU+9+yZvrF79exKaI7o2PJa/OjkskGeXaU+EYn6A/oNK7OiRB1pDR0OjXfM+pwndIR0KnRvKKV0yl1tU45h3SK8ASAWaNT0ajvVdqdb3SGL8uvTLmVM1JHlMg4T3VmMDl0hmUbwM9ABv0RnbkeyqBkz5/MgGrVmX04EPPMN3pjkxRutNHbm+Ns9JamVW+phPRWHlDrHt6Y0Xu6KXpPW6T3f790T9nSe3lYE9OcSPgMVvQAP3NPyQFYEZagRmsqIq37jjDT6g74Dntkg9o3+mDmMzpg+kC3Rx9cjx4oMvogCp9zgA2ARjwG/JZjIMFPwE46zxxDzo5jyPlzDNmgY2jTxjNHupvmGLFXj5E87TxzdJPxGPMlx1jecJWYrQLgFwPQB8dE2qVvjkryWsrEM8cdfZjTgF92GtDLOw3MxxiQqfLNaWDThkJ0GnD6nQJEIQCWCjhnTnOZbnwgCaiITnO++AT94ZtTbYJQJhum8o59c6qxtAXeUV3NgTzVkoQodYtFMbUllCu1rgH7cEYp+63hh51qhDYM1vYodaZWPJ0l/JTEZUDPni6UtlPTH51+PDVUjKeGjuTp9pkNx+zkpvmpodY4dfZb5pn6kUXqRw5K/Yg1obkM+q/uDg7PMMBEhJK4DMy09oHnJQXi4tz0m1d0Gdwy+dGXgSwiIm/wjBRzeuQX5y1dGC4E1ge8Bsb6h3PbLgNpM8C6euyfISqwq8g9OeSHXYY2QanAjBsCDtvFjdPFDeMlkCAnks4HsoDdfwlsEiDDLp9k9OibS/hbzyLVEecZcR0BpowIzLRLaNpXgIN6gd8h+RkZ9uXRN01kigzxUdMG/VcLIPgKTGc+ME/perUBtHS6YWqdyUPEQqUD2t8GRg94m2qRqTbGos60ATu5DdinbSDZjoDn0wZ2NOBiomU01bSRiVFqwZVZu3B6ASnZuB5pX/s29k2763VAOW53FKJ2h/6U+wpWDmD0DCks8PIatxARAIrIYU68X1kKvkLKjKoj9B6Q0dAncrvMmBFsiAmrhGUCFmNi5Z55jYmCr8PMAUqHOmJ9F3QtMmAxgLC6fQDWoHKv+6a5k3vtxBJDLffavUbDUZf72rwjs8Y8Acrcl/sOccJR4iF1/gRk6sA2dVidyWgI4wQ0IYA4DLBNfUC7po2GGDhgmhN4+WjI140YJgeUwzElLjFgRxkxd/iTL7HLvSej0ByvnoweMYgyesT6Jwk8GeWIlMsohzQBVecDzlbIKMd8BQJFTB3yzvs9MEFmiidi2xCoKIBp8IE2gIGWIIOrB6xYCmqImBEfV8AOa0+CHKoGyJQngULsRwJF4QiU0byCEQXClHidERj2gIFVCZQd5T7w5xOmkgQbhsYHngQbc6+QYdSQIxZsXJs30FQSbKBEgWTMjw82cIUl2LjCnwhHSHDoT2cAa1CANRwQ7pOAVg9ggw7uWw/QpxIc4CZL0MBNlqCBI3aP2huOedDIa3wH/DXG6m2JNzaWtzob6Dtgqp0r5cl4KmXjIxjD1atLMYrnAjTIMPdOqh4irCOA7zlROuCbHyJOrodIHp7wjCQBBf8h4sR6iNjDDxHMCLCZI6KDVDmglCFFYP0OpIknLmoBPOPFT5J++fIwc66Th1SDd8/MeEBACzzMsKsDfOyJ7hHgbH+YuZfM4CMBSe3LQyzlEDZBHjKJ0QVrtw3jIQX187BGMEj+UDcFLn90Npl7Eqbwo7F7g34JUzPd+cAzdSBTjIogtXcFMchhanQCcMCwo5QTocIzMs0Ql0RqMXboiNQVLlzmZkCUbAbW/cCvL4DTEliiPrtIsaOA1DJm68Ml+ytcwhoACk9ncMMlRCyVCFMfmOa/87PAl/DH774PlTnh3co14Z9UKpMNh2Cy4fdPsLbktyDVMuN1Bw8eqSxAxBCpbuACDriwk8wZLHfRGEXnBxfPlTTKk0kl+yfAxrEnkzP14YQ7FDJpe50D1MeT1lwGvkxaLEP0DhnU5NOMGmvqvnia9aedDzQhWzS97aVhUxEX0RB3kfEeMrVP4frJ1GmoaQbX5GOHDOo4YIN8NHqcoUK4dkeIFC9earNrzDRkUej2acbpDnREMXfYEsqVhKKbZgicAO8RUbqr4ydgkz2xSD2M27ki0DHTnFpmmmOfEXiLOOumObXiNDftGaDimua/NfM0l5qlqD+muXsG04D4QhGFoDUANTBVWA4BLbpWURFPlQS8h/UsoLJbRa7dCstKmSr4yDLdIBgk0w0iJYDaEusBAZUz3VBrTzdwTYFpRrC7NtiRBcqQU2+6kaO7zZdsOEemG9q56cZGO4I9trEpGs0oIXAZwL+WaeW+wjkF00pUAtho5kv0ZOZb6oh4x8LxTpKFD+2nsWlwh5G/T2VVS5AhG0seTBGkNsiQR7i9KAdxiWIE3nBBoWFqhQ0QptSA+ikJZBMZKDKoqSHRMt9GEedREtgYTqq0tceLmFg+EjmuxpSLZGxgOJMxezTh+gmApU/opUoSciSS0IwT4nlJwMdKQnjJkoTy1t1JEpm3HczRBA2P5BpLWnmSxBygJGbXJwlHNCmw1QNAbBLFCZAo9m2ydq1hpEcSxgck6RBHkqSTtPUkubrMKz2l5IowDMDnrpwqyRUuPZB21Hcvc0rqy5wNeJmbcuYDWtP3ecFOH2CjyMMcnSsfUBssS5DaNsgQ9dPoDVO7yHxXd0jtIwPBHTB7P6PxeHFz/KVB6BRYKwCRbHnp+jNBl+eFYRZ56Wzy7Mki4NguxtiXksVY/hoTx9qXxSMCtZC3xSNisLJ4tIGmwC4mbi/Ml8UMS8ZygBTa82vnySKm6ls4H3tR9SfnAS0q6s9Fhf1e4BLhNjwHd9s8/WDG8/J3xndFrB3eWStW4cSBgNOxqLAmA1RAW7+opBzK6wyp264KUi+Pniw+OcUWn5SUxadNULnuj5qAIltorHOAhpk0QgtNrwNoCIjBQlMvLLQkygfSkNK10L+BebzQHO6Fpu28+bLAnsjQxbV7V439IVnU8gfqqzH34S0sakrroqZtWdR0/hcddvZlcaUZTYeUz3SIQK+kzitPh3Rk0yGiFMDr2CdmrDYd2iQlXrZE2hHL2Jf0wR348CR94ARMH2gB0pBKMw25Kkjd5ExDOp9p6N4a0jiloYQOk7MPTHN2R4rYN6ECh9AhYalfOy400lfO2nSDuBqQX++A4p+QjyT0caEiyl4K0/87tW1oF9KNadDODVc26YauTbqhEUw37tUbKYc+kSC6KOnGfktN3uFFNu5oVGNmFyH7Ot1yoZdu6UOhXbtev/iS7kzeccjTnflokCF/jWli0x2iIJJWt/NGklbmvbMxeqCiU5h+id5hg1mW8E5kHNP5wEXjyTKgpC+5myHLhNZumUgxu5NlZkYzmziPb6nYA8um1985UksGT2XZ3M4TyLL5vbRbNhTFZSPt7k6WjU3Ubz9h2bH7lp08zAgo7VXM0XZ7y8B7SsCmrvYU3dWeYrjaU+msNAVipSkJK02fbKVvG1lIYbRXWo41xXalsaWGk1lQ+auazuuqNhrvYVRJVjXn76pxNTd0718HmOhF4MvryOlNDymouNfI3C89bEzAhXidYf0vrzMax9cZgk7Qba8z9vIrzkwg3w27J69x3zz68hojCPvrjAwJGmS4XvDldQdXMtCeZAHbmQUIp0jmBiwLuAzJAhr8LLDp3Jds4QILvmTK3Gfsi0yZH1cC35y5tWOm+JWZwn4U0KauOoWQJ4C+yHiWQbI1NWq25uzL1pT4bE0vJB+wZB7REuWReYsINDiP2NI84tI9jyQZE1BWeYoAseSpGfOKtjNPcdJB8tQ9kGLLAaCIMWOVEGhqnrKped0fR8TpBNAw5rV5CAnWULuNJGY8L5mh/uiPkUttOWR5bdaKblbOWCQAnzivjWasCamTy7+4utsdwE7Ja/eptUz4Qph6SG3OXXXgdeQQ83bG0163SJjkTgLzGuEoLiD+TeWU13Y6IKA484aOQo7AJcG+4r4J8OWK8K0NF5l5Y7YpUfEezVTeyB+8JwuWdH3ZSMFaaKDzhn4mUPuSY3Vtgi9kUJ3nDaUi7/rTgeArnU+Td1SueceFa945OeB5FODCe67HOtcc6AEfuE3+3MWIch6OAv4a8/Zb9/u2fiIofR2jEXmHSCKwcmv0IqA+KQL6HcWYjSrGlNFijD0DKca008WYRqQY01wXY3ZHMaYhKcZcdxYzHupEtTN2fTGj/S5mVCbFzBRzlEKMbIoqZxRfQBHo6GLmap5JtkNhUQFjOQWPXQLr+vY0QpBSzLG9I8Wc6/GCJw8ArKMZGPaBfGDiBhlchBSxgXdcxCZDK2P2TZGaj1+eFKpvZ74UiqvpHTLcFysGIgqFD4A1Ltb0+Iotnali25+1D7jzPVJsqeeKLVcxxdZsawJuXrGloSi2XEIWW/OzIVo+57p7y6lUbGWao9rf7nKx/Q01IzR6aWuTpRvICueIgFuAtaiov4qK8YCi+rujKtedlRwYqJWict1ZuaGs7DLjUKpBfxz5wEmxRqROgBkPiR8at52iYMYxBBAIBlRIjaIGckk8UTgw5osamimPaYkacsTU0L1tKPncw5jD9qmAU1e5FqqASkphr5/TVgVcGqqAOlE55a94BlhUQPdQ8fAFwNoDRixUgMMyABSsCuSoHGoA+7lAylrsK14Ucl6o0L0vdC9y3pMK6QqqWX9EkRm9ETWjiCony2rGwVIzV3JODaTmrmVzqR99Ud9vW4OiEkQcMasUTxID2/R3BgcgwS4kACFSCbbtAG5XMuPk8i/uClKlEuoSlbjOThjnUIlrXnKrnfKsEmlT1GeXiRugrD+dfaITNUPqt5VQGeNtKqOnojJzTHBb7p1WVpkkrIAKSSHEwdtvt+HL6PvcAgMqw64Ltr310HO73wSkeq37dgyYj7EP/GxoPteaX7WGc4N8We3Z5LUWdUNCwLtc1yb6zp5b11Ti64ZKYs2NWPmL5+4QQMWX/TVmdP2vsTspwNTC6by/3OLsrzGXDX9FjOS9BVz4vHW3U9b4RtjGt44rzrfut1eHVEtAZt867KYAcN7fOhw/ggKHWnxzfuxNnb919Iveut9C/NZZmIXNAMedsdty4CkIt++CDJwzmCBj3uvgDjgFvwP0mzmdvY3TBZs5TdJmjlNYAMR7M6f8bOYSNHiaoWG3o7GZS5gR8Cs2c1nFPoK7yZJd+P7ILnl/xJEIeZ9BnrGafs+pqd9z6vP3vL9EAIfwPed0xVGHocOcOF59wB3pRKq54RGgrisRsembxqWcuiv5hwMAOqccsEPLAU4oACtN9VIOOKClG7GSB4OBMiAgnOWQfnPpdlO4lCUwYcrANSOgdSsDCk4ZUDJLbhdKGUjJTNGaYJ1OxZQBHUIcSmoIhFbLkM57OWM4sHQDU845j8o544BlJu+5Lzrq/+U8Cr0z9zsfyHkmEKkfj55byxDwxvSOX6J3wmf27Gjtds+0W8TrhtEM3dzOm4puZBQSGFDdyKIilg2xRkW/pIg90VeOrXbemr7S19JXfpq+Gv0C8Jv0lc8dAhPMAKqUw/j2HxE5jG+gWBzGXFIcYqwGXhpPjqmBkTmmnLPHjGJ8zHBWVo4ueApUPnB0fv1RUY8e1e1Amhyd7378J7XU8Z9SouZPHOgFdExFePykBT5+yiX1sDxCLx5rukbHWtIdka0JDMwRu+p3Uod/H+n3cKFTh60vdciNtRgZEmbMuIVT6pBRkjr8bViRmhHHT+ZfM/P+b0/qV3rVtduBq3OOeO0W9HXFDYNzRgtzztiHZxehPmf0ss4ZFxfnjErsEnNkLzGOCgMQ/UtMr/YSS7P25JJwLC8Je+PCv6TIJaGDe3Evujj9f8k49y7uRZeM7twl4+bFxY3NhX8Lkcue7uFlT2m57M1z5AMXFzS67DEMMBFfO867rx1Dnl87hkfalIa3zdiwNsOxKGkVF1utolPcKr6ndRGMdmeqM8D9oPaf8oAin6IrX9oaB7ixjGxrrGwD7UvbuWPxvvxrZt4/mfcvZZ867kr8S9lvKVXzr42ddD7iJHEs49STXxWjtr9q+91teVxjM+xoP66xOzzlyzXFnuWk8TCiCHFcNzaOjHpk7de9O+fsyf+Jej3z5d/KPGk7Gnh2iHiFZ4fYagZM3Ph2qG8WCikGmO+Q+nu7ARc5n8L2uh3WpuCiEakfV8/eV1BbOI9cjH2CB0nsaIjetW7j2OJ4c+7bUYZj/s+BZ0fcmLWjDDt3Fju8mW9HGxy0mDQe10EzH/jZMDWOzJSbOnYcuU0epvLaNPGdHcdG4Q9ZogKPh/mVD5Qh/k5kH9RtyWMfzpiEBK/Y9Icz5A1YfBKuJOQNUK7Iew7AZAPL2LN/1BBe+wf+1uIDJffX7WTQtwMP21A/Pj07aaHk7aSFErWTFooSCFOiTABIrZ10+CE11S505Nsoup3othHOdiIDU+Eh8mw0Q6TTRjOoeKDcAHD+bRRDmIHX2LNRDpttox1kzEZ7mWzugGnmTsh5NqrsVPsQ0KBj11JAORLfGoQPcPAnT4lyAEAP2udHKG3gSwHQWACa9/zIAX5+ZHc+PyIUid24yQaCjdTtBc/cIrHPFRZLdp7BKbVz/tMD2AwAbLLYP0N4i/bPUMrQwwb78QogfgtMzj42MIo5xQRbGRpb15gbOSOA04Nn464/bQAEwmEUEp7SsThGFQAcGGygxASEN6G9tQkcIJ9H47hpzFR6B3zPEER6qXn37RdC/UjxYAVS2C8t5x5TGTMWFaY+UjgKHPHWMQPgzAIJmrOkoDq/CGgeHTQA3wtQW+IY+oDbV0Sq5W0OSrKUUBHopWQJR5fvWcIhATJXuHBXCsg4WZKsbwZ3wL86npLjLXP/X0SIl2YukMuMJGJG3uGQNVJliLUyUlVDHF3BE7oUW8UodbNiSH1c/b+DmHc2UX9vg3rWLWFtsut/pb5NdvhWzOAE5sC3yR7umxp6NvnsWf0ndVDyCTsGQISTmvMmqRFqs0ltpmeHlqjQ37UEucPmDggRD6OYJrX70DMiZjZpsDWnD759QSPhl9qXP8wpAeS+9uwL/8kBoEEvPM8GrHiPvf6y5Xi+8GglAAlchNirsIsQ6067qFgkDRAqAKBnUhy0B/hcGsA4AcXWoSLQbyn/WmRTbmLZlAs7m/KoKoD3pQmVekrzbNPEjhy+NZ5NMxxqsSncOixLbdrAYQQK/rsQKZ0AdhwTOV6HI82eXWKM7uwypFKp2aDlE5aYOOOGdy+fsK61y5hTfxmbSwDIfe0DN929jPllyxi+l10mroaEErF0g7NMqDWWKbx/AApiyUMQQLYjIOJL/pvRLiG5PqDp6t1iFkCZe3YVUfetInb7KuIYriLq4xUPp9xO/gEwPasfEqZ3drXGX1FONdxGu9pJtqNUviLAcGdfg//916V9xT8LPPu6k7jy8CdGjIL7L6PNefDf5jNOjXxu9AHgF+cxq8xjNjtnBAhA1+UV/nFq88rgI/KKGiDXbHaR9r+e/+8AK80jvpo9AAA="
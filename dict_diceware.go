@@ -0,0 +1,5 @@
+package passgen
+
+// Encoded and compressed original Diceware word list for passphrases - diceware.wordlist.asc from https://theworld.com/~reinhold/diceware.html
+// Licensed CC BY 3.0 by Arnold G. Reinhold
+var dicewareStored = "H4sIAAAAAAAC/yz9WZLtMMwmiNXloBd7DY4oV9V/u8vhjsw7px2O8lIMSZDEI5JQcpCOzot7J95pt+ND9kN+BwQBECTBQRKl/D/9f80387//r//p2//5P/2n/+3/9/1//1//0//2f/n/fCNL/3FY+l4NkSUiR0TkiYpkQ6OlkTyN/wdOO7Cy4gZsoEeqjsZRSb6B0gbFZmmcLI3saOQZEAHN0xhmHmh88ATRSC+IRJjIXCyN4mmUGWkpEJeueEKpkGYUSgONVcroaewVBlTortXQNNBEcyBP01RoAHa2NPFAE7cwOZo2djSFeQCEydKUHE2JPU1SsqOpgCxhtjQ1T1OTrFgcTa0ONPXQbk9Tb2xotjSTp5lWHoBBkAjF0UwJZKrAo1maZ0/zzPNA8xxgeZ4DMmfUfubIjmbOIA9kBu4DzY9ekYhcPM0pNMWnpRnlyIhCJasZUS2B87MU2OmxDTSfnL9+iv6EyobYE6+hDsSZw2xocbRQGWhZKOhPyOzx8/S0BJhcBF27FEK7LSVM5GgpYmlphlZPK4UMRFysdLKllR2tzAOtnNFEK+fmaQ2o7RoydDJXxcvSKp5WQamr5NvTWhj6HYyLbkObp41pdrRxcrTJbShYCrOjMBOAPaB6CjtnSyE6CpEtheQp5O/NUcjdUiieQuE6UCiJMn4uuh2FcnsKNbKhh6MHFcDT0O5p51Id7ajgrqMjWorkKFLwFCkJyAyypIEi1Z0sxdFRxOiJY0+e4iSgtYPjjEhHf0eePGAHHhuwNLCfA4CDo7hCb0WXxZUiELEQV4EXEECsx0AVOMKjMEE4cB4ohnVrSKwoN+yagd6JsBTRqvHL90g3UP2LfA8UY+AZCURXjNIUL0XN7gU1iKp2q7k7O4qJBopJKuohKE8WqMoGN7WHo6D3o8gC7LOleHiKx4Y2PaqjWMUDjoFi0x6LjRS5OIoNuU1QWEeD95TRDicKONFLUSMmWUrkKVGtwBd7SiPaLo2RB0pjr5ujhBonzjPIaikFRynMHgCNgMhMISuqJR39KVF2lBLYMomjJDtI1CwJOjEJrB0DpYMxgtLBT2BUOt6OEuan1COmxdQrW0q3oWwpk6U8e8oaz3kuPChejvJ8e8qcm6PMl6e8chwURRMFGBgYv1CQLZGQQIznFTNKXnu1lIOnHCQDMbvn0CC3Y9zk7ChnJDNFkMjPqEPOWkAWWMsd5ecegyaSpywzO8o1DJTrhVBXbxuYDVItTEB0YO6o4BmipXwPlO9NLvxoB4olNKqURoYOTwdhCjuobbelgx1pix4bpqQjShoHOg6mqD9ov0Mb+zgQo0cJEYhSD7T10Qx9OvrsZDDYC40ecFsq00BlokyOyrSB3tCuZcaIAjZLiP3C5KmwymFCKWs4wUbElBWtWlYMm6LTWFlR17JRc1QQYCWgd0uAM2X3VKLaj5zBSJ5KQmCVdA9UskSoCCK6CNa8coAshNzCGB6lYHiWKtlqS5XGBblt68htAVoI3tJH+NyTo3JHQ3WgOnGerS7udcOQqBuXgeoW+XZUN0AgTzXMbKnunurO10A1Mh+e6gHf6xEKcqunWikoJsUbyG2gWqXoT8eYqDqga4NQE5TXCkWDJmr/0Sw1HqhtnKunFqk6apIGACKoSWZwDk+thWkAFjbUB+pj4dtTn4MoNkt99dR1fPS1lwFYwd4ddcRnL+QBEQhrvbaQHfUmA/XWUzZ0ejoJsYpuPmELq6riPdAZCDF+Yk06QwVAQTSNsDvlMnR5ugjr+EWYjS8qs6LSmJAuui1dSC49Wrrwlz1dsrOjq9yGnpae7OiJ8HkGePwMkhw9UegzIgur1G3pZk83l2ro5enVsNq8euFvox2/VzOSG2lkP+r2bKTxdiNNG2AfAP3wI02S7UizG2mGKFawkeaVh5GWJbIdafUjrettR9qGkTZKsBuiHykU6IXmRtoZ0N1IcfYAFBbBjAGAEuNOMB53zYvgRJ71R2AjJuSmG1jJjoR0GoMdVS8TapGhkOfQwNFSMkrJK9ihbmA/BJwd5F6BrduRihupjAB4XVDDskSUVlaYKPCxYECMVDLozNAuognJmlHAKax4g99YWW3ThMDTqp5WCFVtenWrhkkxKqpcqMjcASip1qAowIa2Ry2b9lmDta+yGkOtwWbblB1Q16+ym6CerQ0j9VE7oKNDOoN9gby01S60/7NxsSOhDe6ZgAyJW/ow0ouomHG04zi6cRxHM052nGYzzgZRxRpLTDNImGTaAQmQAQU5RfNrA0OhD4B2g92ffuRRjmHkiSI0EZo87agnT/ttRzjEc0h2ZBTKWjRr0byAXFA0o0A+AAXQhpEXitGPvCBUeJHVjrz6kVcEE68qsq7q5BqU1fMw8hYySgwrygkIKw6lN6tjiCP5keO0DSPHpaAOMTD4KCl+5UZW1Ey5kIl6J+RhRzpyEsp2xEDjrBVBTHOetdIZYcYZKvnlRy5MA3BpmgC/wKkSA+pcYKVkHhRVppAizBRlIFK43HCxqoFaB4DWqKIdagvFjprXoN00CyHNbWMotor6NEQhN+28U2XOexgZ85vFJeLIL45mXMy4mnFzI/bxY3BjoGrHMLoxjKMfw4gmChMfgyLyZj+GGVEUZnZj4GzHsPoxrGsFYn4IqhUxVkPcAXEAyAV2vO0Y8jCGTKh6yDMAJjIms4ANEzjBj0FQyVDQ9qHMAyDAtrZUqBjvoX52tiNiJ+gADA3qGFehNXXv5cfw4mbGhxl3M0Y3Rhr9GHE5PUadTiOFAtyVTop5UpwVVbIoH0MkYsBHai+vl85+jEy7IjIxFCJ/oeozZo3IqsmXH2NIB1AzgxoPObsxBmWj22PQKSMGLUPUrowAuCXTpghNUacFsQnUhMyKSfGAVkOuNpD2Q5QL5XVId4Rj7MsCBlSwEI+xo0V60bwKvRMl3G1jMyY7psuM2YxiRyE3Csan6CQi2kJCzY4yDqOMI0atjOONxISayASVaXejIIpkvu0oqx9lRWzKuir26kZZbzfKVtwoIQKqB0ApzgClBAC7CQWmkWAvu1EyykGECRYfXBUAIJ7hU9YiMkrLL7ajiB9F1FFZMV+I7OBgIRRJAKh9GRPUWLSu0kAiKEUw5kTU2uFHKfQcRikzpjsp0CtwomCNkYJ1RTA1iE4NQLhQtJiK3hKsTlLRlVK1TogOqbqIfHWoYPxLaxh20lckep7gRkeASS9Q620Y5QwZjl1+lAvThVyY3eTSul7RjvJ0ozzRfNC/tVfvCbk3TN2RzXiY8dOMxY+FJlZsbixYX4quL4UYsCK9KgbNw6gH3kjsKpNBInKBTROKWIcLoaZAVegqe2qBpwCvCBPI1MWt6BAsTGkAts2NhZWPvUthfjF+EmpcWMvhqtgUT3iE0VmC+hVQ+RKwjpUwfSEKCLPSvLixhHUArBV0AjtrJgKt6MAuX/UNFbSonzpsi4QI3OGU7OqU5CdQVEj2QZE1AduiVULnFbnQlnIhOEpX53pow1h6xsxQehZFVKxrY3asCOXGugZEhvZrubMZqxtre5ix2bFFM3Y79tGNfdrt2Gc39nn2gBuI9bZzpgEo1Y19WezYVz92HbcdF7xj17bqAZvMHjA2exz92HVV6BF5ur3sWJC7Lgo9ubGnw47o6a96dN04drRj1/W25zaMPd+U3dgRoh1XhmMvTB0JrIwdy24vAX3fC+KsFxRSospjV9wx5HrJKn0ACtJFgFW5KOdrl9hLUw5crH7sOix7RbFV19yOy7KxV7SFKleIwkAjhENvWpfG4saOWOu6PPeGlb4/Jdmxw7Ubc0R/vTzgNuNpxsuMTzPedrzZj7iC8OMd6RrGOwZMQjcm3LuglLtgdrhhGZftw3hfUmYzvr5NdvpezUR2otFPNFIEhgyMDJTmJ5pIgBu7iaYEsgU/0Tzfw0S42kaCFQtk5ttNtIBaYWRlyG1Y7CcKibKbSMvAkJgoFKWLuIl2thNBLM5c/ESRRzdRXADwLUZCZuwVHLgST6ikYdJbR27CEg2IirCZDjsRisjf4WJW+5kKnMoTF/zMWtusjqtTOVRgRnE5ayvkfANFsyUjQw5V0Nwmg2K2Ex1uogNyh7p0iJ0I5RSsPROVGcBgYP3HT1NEAWVFY5eAihfUokRSVLY6V2RUjBA5YObgBlrLKEFltH6lfhWo5puW2KsaOtE9FZKVUYm6DQC+QO+gd7VZoYormYlAIWAnvYiZcJdnmKjtAW3RaocnfdpQCYzjiXpFISeKPVl7+Qxo/FNKsxNdw0R3X8lMo53GaqbJTtPkpmmazDTbaZ7MxH5idZOnEP3EMxU38cxuYk3HQG5iRBbniqjQaOTCIEseJm5cyE/cejXTYqbVTJubNpr9tNHCigsYASTCcqNQgKjChvuD04Y4QSKvig3yAlIqSBU5OIJW1aLWS1JswKr6NUGyNoakWrn8tDEdihDEneZpY94HxVsTxU0bw0mGe3hMAOwjEoifDavVtPF5Q+ay0xbctIXJA2APC9O0BV6AcVZUTgyKcVBEaSHBOW2HkElRJfMONhwNCLktVK1vQMHhhIhoUaKNh8Vp2iQdbtoE4nIgSjYpmGI20QaSokKVB8UM2Q5Ao5SAptU6djXctZv6CkDD9gRnsAJMG2btacPjninYKdAwhYlm8lPQaSTgGnEKMYCD5WMKGXNXODbNLhMpspsCBkloBaEU2u2ncGJshhOtGc4QzfQw026m6KaIIIoUEsg0ANINDqodMeVEjRhcJIBxgEQw6vUCcB8UNVE3RRVCp0bqdVBkKF8AWGc1zKhR1JiJuLCcIqu1MG2wpt0ew7IA06iofPUH2w9gc1MMAkCp2otRCHnYgU9RZgA0BI2tzwamqB0GhDpmxoj97RSlz4rK+bJ1ZYjcboroyNjVakd9+gNpDK7YU0W9urp2z2ymZKZsJvGTEAarIGb02mESUni6ScZxACCOZRRIjIXcJJPCPgA0c9pvcGBuErITaiUzDZPMc2TQkJoxUQoWAOHZTrIOk+gDsUk2hKZsgBABoLBSYQIWzM4SRyhGWNYyI9YHoLJhJL7gV1KA52nUqqBXJHEbFDckEGmSEjzNfpKsTYCWl4wFAlcpwDCBjWJyxiImGU/JJsm3nUTchAuUSXeJk16mTCJxALDSqIMcyCsVZLOTHG4SLFtyaEseKK1guZQyYrQLFi4dtVLUStkB8LJoTQsMlqNC4+zVTlL9JJUnRdS3ojKYraQ2lFHhLZKtkSLK7BiiuF4BYroSXHdO0mG+q4fYhk3Sq/YFgk1OTI6Ce75AVOfyk1wEgQt1uBJGjlwFRT7tJPcwyS0NyvfR/SQv7ejXbabDTgeZ6dNMxU0Fm6OCewJToaWBsYIMK8gEEsOj6M6h6GAvpAyM6aKjueBOuWJBAqtvweSPaxXgS1Vft5/0YmXSi5Wp8IwJtvAsQJ4VNZsPZGu0FUagFD6UPlRBy9RloTCWGzyamkpARYKa0fmgBHU81C+Ejo7/ogOoyNSVhfmlSHgCtY6CrVDRCCsaSgWhVKRWkKiWXLNitlNBi2FuKB0jrXRGjTtmpaKTuF6HAFXwCXjZqaCI+2hmqmZqZup26qOb+kgA9lMfw2SnPg9Tx0C2EyYWXSV6jH7q8YAkBl9PCJOOkYFNSj+WDoEjzHbqxU29wDCCu2NX1svCl596wXrRdTvVC+bJXqBW4FkvmJY7Fveue6leDzv15qaOju4tcjPTaabLTE8z3X66JywX94QeuzMG+V1CBGKtuWsz08tNL8zrL562b7Ofv1Npdv5ezUx2ptHPNE00zDS1O9qZZjdjkZuxEQfJfqZlAbnFARCQGQo4oYKst59pp+JmipCODEaMkIi3nSm5mRIDsp8pSQZ5DDOlyigS6QyreYJ+1oIzFPIK2MHMMJob21lLKsgu+wBgGCiwXdSt0txMdXMzNQLAKIbITK3Dl45KY/DPuL6f8ehGsSoqG2auPMx04bHaTEi/2MyjmSczz3aeZzfP82xmHmamCXVitB3TAoge0EBmkKgNUwHZNj/zqPQ43sDQgIUGYKhu5lE5fVVsdubJzzyp1YmgM03kZtwUn79uis88SUGiMCNRbjszymT4xAlwACDKhe3Mi595YSgtUiCwwN0F1leqwJ78zEE5YUU1QrvdzA+yM8OVqK7EBc7GLQAD3IqaGQnYFE+0UqKy+5mTVjcJDKZe7MygMo/DzDloHbWzOVdUIDfwmzawsg+B/tG2Yeajo3O5aDsW3u3MdZi5hoq6VDRPowkNrv3PfYLJjtrhomFmPKZDAp3Ml59xyxrIqOiFRrzNvJh5NfPm543GYObgZnUzaNAGygws9zAH7BzcHCaF3c9hamTnMLs5zGLnAD7Pfg68Csg0zIErR9AN7HYrvuwcVj+HNbRhDpEaFGO0c0huDontHLKfQ6bNzQGxhS0YQBRvMGBPZogefg6FJjeHAlfLCoWC/NJuOyPiQkU2xkxAq4WaMYhxhxvYRBFmT1T5xJDFzm4Oz6CI3gy4izA/zLybOZo5mTnbOZOZxc8yjnWYZazoR6wDAG52ltnNMs9+lhluCZpJQCyLnWV1s6zsZ1kT+VkiBpNENK9EhYg0ZhrBYBO0DFwRHPyZJfMAQE9IRmdJluJmkQQAdcBKQU4JXzgpVjdLhTGYaTDTR0xu0jE0ReNIMD6xoQBZQCJg5YTa5Wfc8XSzXHDnyhVYbj/LrZ6/YOzF2cyHmT/NXNxcMBkXmgSIwYgdwVwIXa03MOdCCSTaoui0WOhQ9gXyioqQZpoV06B4u7kwbOEJj6JyLjdj8Z518QYWIIZzCWjZguuzuSB+ivZ20QbX5XouIkrL4WYs1DMWauCX4JXdXPoIQMEdY6BgQildHcf9rRntUW6azVzN3Mzc3dwxsjpltjOm6T5uPMx9jGiEPlFzM/b7c5+anfts585u7mjozshbFg+47Yxi+4pA7Wg6rN6AG1iVcbu5JwKgoJQ0fYA8oI8CM/q5Z4V1mHvesJj1vIOOqHrPKOFgP/fCxc297h4AY7WBxJDGLTk393ab+TTz5eeLyuLnS6fKi2Mb5kuPbM3XzcXMT4M18KbZzje7+UbP3AGr4b2zn++Iet2Zzfz6xo6/c7H8vRomx5jumFbKQC7AyJapOKYSAcgpbXN6C4T1SbQ+iGWqt2VqnqlxVoQ6pl6mkw2PlsfR8YjMUfJteHI8beJ5ilCbJLLh2fOMmY6xgWDM7jyvKB4DnOf19qznIHkOCwSCkm0bgAK5TJZncK+QDbNlZsesRHTMMnnmEtjwMvCy0MSelyWw5WW1vDTDq+OVsuV1tbzKwKtuXfHTPK/Y/vFmOHjWK3lGyzsOhQ0/POOIquHd8s4G5UU0pU7BHEfyHPE8g/VWIkfMNhwZ1YmMqkXONHBkXDhwXAJwDdlyDJ5jmBkoEA+NLcfdckQhEZajKsVQLcfkOSYtA5MUR/RVrAHYtNCutrAAYn2Fym04DZxGil8/ZfecRnSIHoDjNDF7TlxuxylED2BFZTTHKZHnJOX2nI52G/iTaWoDZ0ocLefZc8b0xpnTbTmvnvPKERh54Lz2uDjOAXIPuQfOenCOs0yb4ywRUAfOJUziOdfOnnPjMnBuhQ4kUHw+5Xacz9uwGD4sH+T4CJPnQxDhhzxvz0eVZPjT8yfmDf7s4TAYC4U8Fw3vQk0cl5ksFyR5apbL6hgzPWADyYB94JJxdcMlo51LVlpm5Eq1XMrApRDaoBSJisVz6QfkTvR10ZCtnvXwE9eKFqyVn1YNVx1StalEk8Nws9zIcpscY7HltiHc9OE6sADhadukAm/w+8yGu+c+oU49ovV64Z0Mn5ZP8nwSouOkXC0eNH2FI44EA9G+Z5iawybI84mHQnxKxBi/LF/s+Qp5Nfz0/CTE9pNic/yk5Pk5cRyAGFFPLsjVMHpi08XP0AZ+ytyRe6hoowxe09KfDQ33bOihp4bwE1dT/MTZJb4t3+z45tnwy/Gr0LfFLt+rWcguRH4hBPJCY2SnAx/QhoWm8MVp4DSxC81uQRssxOW2C61+oY1vt1CIgOwBDSQMhnID2+YWrLR6RGQhLBQLJbYLQT5P0M8wlfM9LJSlN7sQDBSagRMUCootqwzL17GhhUoCiFuoQgFeNoIfDaK4P7FQj/Cmo6CeUVVcui50ZbsQbLzYLKNdxmCWyS7TZJbZLjOZhd3C8EEn8YWp2YVHu/BsF0Yez4AIaH7RrfzCIbdhYT0zjJ+nWxhV5igZJARTh1EsgQvG/cIlBbdwycPCBcNn0dMkCyJ74UZQxzq3MGrFLUCn9eoW7iBPLnbhyyyLXZbFLcuymGW1y7qZZTNLcEuA72EcljAW9FCYNnZLmAG8+CVwnIFZEWUH1D4s4pewtE3xtktY/aLL6RIiHEJ8AhrIOAAQRiFGmIgJZLqBbbOLlpwJcplUM6uRPAMY7NBQbN4BKp1RanFLKMgosFjQG6HCKTywW/BIFgABREGAe9peOJm9hKdZHn554CHaspsl+iXStLslInYjBWWgjEg7yJ0VbzASyKSMrCoHyKIMeBCpKrsNAHQJ7v0ukZ5uiUx+iaxFIVQiQ01jJXLdwIAoP/2id4CXGLgMS0SrIIHxoLd9lxhQbChKNrtE8YseJVmiTCC1DGEAlERmRdRJjgFw3OAUeCQIvyhd1S+QVwbes11id0vsIwDOYtu3xI6Ai13bpatXXeve0VmxP+0SYf1G18c7Z7Mku6TJLNks4hbBgBQ0pVC67SKjX2RS5hRA9josMs+IYmG7oAo4OuIWUUAHCebARRClEvkGBpiOO0hEmyCKBK0lAkpgX5oHwOhhFyl+kULQ1vlECgTRmVJWHoAC/bI7vXJfpEC/NJXAKJDSVLsjs8LtWjHtSYePHcNHb34u2sZyMrkFxzEWeboFC+si2IYuh12OySyfZil+KRqChRK7pWA+LJSnQZE0sSu+kNtB9hnk7ZfCtLulILgKgkvvOQLLsBTOLxURWNR4Kwi9wqrOmnejgIA5Ts9ELHrBshQdy8AbP3WHbGgvuxTxS9FoK7ICEtIZZqUqopWKBlTBpdhSOgIWM0q52SzVLM0ubTJL90uftuoXnERwS+fol76SYgfjEdzSMXXqqYIFTd6xYvTslp7nYen5SzyvkEQrdZ0uenFLL8jRWbRjBerlBZtVAYXWCm5tEHi9/KJP6pfTLJdZnma5zfL6ttr1ezUr2ZVGv+oz9pVGyXaleVhpXrm5lZbFA9iutLqVVnYrhQjIgGZXQirSAHjeoGEoMvJjBDvKARqyya2YcFZKIQMTAXsbVsozF7dSXu1KYleCzoFywS3jsFIJdcNP5uJXKuUGtg3Zt12pupUqUvXwK+FiVV8wAlSglGHFM2Gw+gzlrtXpEWRGbTsMowlX6i/knRA+GRKn1vjaPQDlwf6LzTqadTLrbGCMqfiVp13syuxX5goM621Xjm7lONuVk1+xZXYrZ+Tm8IUBKAQsMJXbsH5ta1fOvQ4rlxFNzwUGCurMza/c2m3WxayrXdfVreu6mnXz60aZ/LqpmY1bE7tuwa8bQnndpEezBr8Gys2vYRyr4u3WMLJf9fDkGpZm17DaFcWGSIDZryFyBanMNqwB1w5+DbgAXtFKIUM0KyVuDWUGQES7C7MdXn7wgGzWh1l3s0a3RkRexC1kjx9GIklBIs+KFVgYWJV+gWZKitmtkRmQkYYDMYx+1QuoFauNX3VlWaOALSOEBcryhWjsKGpaTs283IrVAjAr3sMaOy6q19gTAEbvYzNrsmtqZs1+zYTaZg3HjPIyXX7NuNG05sOuuZtV7CrkVqEZEAHNrjK6VTAQBG2M21mrrMMq6xrZrbJuAHGrRKjFxa+6QKySkY3BI8iV2QNYEdkCSVluYGW7yjGsUmbJbhW0pxS0GVYK4A65UmGyQBvjTurB0a7S/Cp9JqAKd4SEdPQxVt1VrmzWw66HmPXTrMWtBRNMoYlBziDhWCF0c9EZBjeugCEqZmBWyaz8Q+WPDYjRrc+x1kKNFHlQLEicKnreKEvtI0IKE+zwqDTPg+KtiV0xKxbF5tbCK8hVsREYFwBmAxwLvAxALU6HQgkJZFJGSmDAaMgzSDgdtBoa/SXAH6GsqLRKaxgWUUEswWuRJprRD0UVRWTi0RYgKma3lo5W7tqcPTezVrtWMmsza3drxxDplAVYZr92XIkBm1+7jg89Bgf8opVfeVh7aJjVuo56XKevWLgAN8jD4o7/2tMI2ynddu3ZrViyADcw38Pai4ZwL93izOzaMTi6Fl+bKEIV6VZBQeemTGY9zXq59cKSct3ZrE+DCfVOdr0Pv94Hpvq7iFlf3za7fa9mI7cRrYDqNxrDqNjcRtNuN5r9Rrjnv9FcJPuNVv7CAuzsNtrACAsB9+42CtHpaZxNr/o2Ci3YjcCM7AE3yAUQkU7wIQqgDRvFkzawT7YbJb9RojhslGLIdqPsNgT8pm/sbZTVWEYF8o50hh9ZAhhwO+MR2EaH3+g4IFugXGYl4U7hBHIftq+LyY2KMqBRDpRRtBalbmDDw3KqnDLqAWgewIq33QhpXHpspMzGBeQ2bNQKz27DUrrpUrphHALQiqdMdqPLbXSh8VHsPSPj5uo2ulGhFxUYeEHrxWjV12220WyT2Wazsdv4++w3/h6j2xgdqG+JbEwRZFTyABSkiwqU5vQo06aPzzZWpxiTw8YjDxvjJK3DOacBgH7U240bozYMN3iB+tJuv+GGHzCz4sttHIrbOKKsiJpyXAkYnmDDsYgA4JgGQIc78bAb+oYTCCjlCQZzCYq33dCsXAgwAuBLgVdFBkCYPH4yOKhtafClVg9AtVoLMNnQaXwNG1+hoewr242fduPbbIvZVrNtdts2t23bZrbgt0CQw2YZUO0WZreFmd0W1s1u4eG2sCOFyul18IaJYguxJ7uF5PR1F0AHcgWiQUNudguH38JxiOLtt1AIGoWHLZQ6oYDqtlBRLoxqqIWTzfYw2262aLZktmw28ZvQertN0NtCZbabjH7D4yJFZI1iN5mcHgXb8JhoUKx2E3YbVtZNVr8JbvluEvwmO4ahxHkA9AM0+03X101iAlkbSECCkQRP8kyK4jZBbIiOXsHoFYxeEdRDZAYsgH0AaAmi0OymxAH1gvaWkkHmG1iV3avbpCKvQr4Nm7RRnn6TxtFvekZlw2Wa26SjXboKI+blVAncSsGJtk3w0tYmF/KvaDdB6m5mO8z2abZitmq2ZrZutz4OWx/HPnr83EDEJ05db5goO4YKDlfjONyGHfWGw/0AjO6+it365rYed0C0W09+64kyEPGFpydbT73arYOZ0VwdzdczdDCdYHux9RL91ovkYeulYDrV8w+bHobeemkg2+02XMBveJpitw5mmzaznWa7zPY02+22e2a/3XMhRfHbzZhh8azEb7f6difObrtTHgAUzfb6Fmz4PrvwPUYbvicbvlcXvp9sAg2BEo6ABMomjC6M/HRhDDOg2jAmG8ZsIDDhj32YsIUMOA8WJsk2TLcJsw0z+TDTJi7MDJIp+jAHSYrNhTmyC7OAe8doArvAzCYsLuC8RVhyMGH1AVtaF1YpJmwmBBtCcCGEYMLDhsduwm7DziZEHyL35EMMNCnOFlfIIcae2IcomUxIPiRa2Yc0drYhHT4k3KYN6ZDS8IMDjyH7kCkz8Gg25MmFPNEQ8sS1Ob31E/LUiw955icQTZfnwNUFLH0h89GAMJoXnpDAcYOQl5AIiQC9pYBeBVKYHH3Ij55tyLsPORIMRYZuInDhV2YUe3So1C/DeMc45Nojfhrn2eOnuJCb+JB74SFk3Oc2QWyQ7IMedQvSyAW5yITDhaOKCZ8mFBsKuVAoAz59KNTYBvhWOLMLJVQP2GwouwslQRpGi+TbhoJcPA0I+jQAwpVoGkKlkaMPVQOmKj9SGoB5dqFG9qHm720ItZBK1s4mNBfafwC+zz607zH60LBO6rQaGicbmtjQmgndhNPh8bzFVVk4pdw2nLcJlwlPE24TXt8e9vG9mgfZB43uQdPuATfIBFLGQTG4B81sH7S6B4XoHxQSuwdO0z8IkomrfVB2DwQKoAGlAjvwoGwfVPyDqkDsRJmXfRAKe7084DaP0Twm85jNg92DocKzezAf7sHLMjz40WFf35x86NGQh57EfnDZQe7KKPfw4FL5dg8soACYqw1kr/bBzT748g++OA7A1vAT6mYei3ms5rGZR3CPMLJ/YATaR1jdI8QIaPYRkn8EPCV+hPx0Dyxnj4d9PB7u8Xg8zGO3jz2aRzSPZB7ZPMQ9BLWR0T1w4gHAt30Iu4dwBCC1uods2QOqe0gAGXJzD9nZPyRGUrzdQ+CFID9TBTKwrLBXIVt5GR5St07gVNAtsn1I8w/pESJ4pvDAZccDFxwA+HD7h9wTm8dhHp/mUcyjmkczj+4enbJ/9Jmqe/R5BjDSKLTPArjto6/Do+PC1j96mL7wdo+uBlCNvsPXHvlQVDqQIivCEvoXFx/ugYUEAEaewMgoL+9II1R7FpCN3KMXBZgpUkAivzb76M09MJk9TvO4zONpHrd5vL7tdv9ezU5+p7FHv9Oyk9tpy37X77/sFHnYKaYAdmZA8zsdsgMPGnbCjOB3KpzdTgVGSoLBIhGMw+1fAm27gQFG2svtGAI73W6nW/xOLxGzj2afzD6bnf3O1KrbmSMgAw678+p3xgPynfW5BX5ut3M83I7N566SWQDN75xvGnY+Ihe389HczgUCBZpYZnd90rjzGbLdGembZ0WUfVezL2Zfzb75faM9uH2j7PctcTFIhWm3e5j9HuaZhz3MmW+3Bz6HPawUIRGj3UOye8huD3kGrIDdA26/B6mg5SK/hzJCv4BR5HQ79o17aG4PaEM9d7SHkwDd7eEKZn+Yfbf7vrt933ezR7dH+BipV79HDqBDZrvH5PbYn2ZPZs9+z5ju9kzHMeyZOhozM81uz8wgOQJjA+Pyew4qHhZ2e4ZHWUa/Z1GuxAhGA9k0Dxq4gt+zPP2ee4lmF78LRXK7oM1lpt3tEsnvksbudsmr36XAeSlMZj/M/mn24nfcSRn2Qr0yEr35vfBY/V56ZbNXszezd7f3Wfze5xdIxHCPKALbrL2XZvbT7JfZn2a/3X5H9vstTcz++hZt/F5NJBtp9JFGykCOwEADMLKNNLlIEzt9GBVpum2k2Uf9IESkOSJnBnP1EdfdQ6RVBHmrVBcpzIDs9JXnSKHdLmINiZR8pETFRUojAJbS4SJl8pGylpmhnZWC/bwycFeBHaWKiyTVRjp8pEO9PyqEyrS5SAWuFq7AFWbKPgACHCwFvhTNPMlFqpCo0Ktg1grrtfmoF8sRYxqAOjb+4vMT/A3kprlqGY/YInU438HFRjqSvmQYCTNWxEyAZ3ARx0zj1zHTSE8b6faR7o52eMGd123iaOJk4mzQH0w+6icWIgI3Mi1IL7fTV8IBSmbAgfTRnL7YEfXoT9RTP8BNsQFP2J19xDWzjYx8hqvMc3WReQfAApd7iMx4J0BfEAHcNvLqI68UgSsk1oAI0OvsyCHSEBl96aOeMImcUFuGj5mcvrQeOaPlsL6DgWJzs5HFRVZbgqhgyXDuUGeKQLTWAcAMGhrwqm2ox+kjn1obXDUBi4t8BpBa2BlQzokaXC7yBTeuAIP3zNnExcTVxM3E4GJAE4aR4xBxPSBI3ORiQJxiToxhtjEgwTMgA7qLmD1iWATQvH6Zy+knuQAQiuQBEzDeYEBAqYQC0ugBMJCglQLy02EjhlVA44U80wBUg2ijkDE+sbMGY3d6zzyGjMAMaP1w+BgOjM5QUQqiIlQM51APQBsADFFoto2HGFoTlHBC+NQScHg6hrOwjeE1xPB6BTbxYeJuYrQxRhdjjD5GuWcTk40pm5hNFBcFASy0AJIH3CAzyLbZKKOPMqLd9U6A3uWPMoqP+lw2yhScfpQAwMBefdSDpFEYylyr0w+RAW4bZfVRVgwOwUwia5hcFMSChAItdL6EhkCRHdYjAaKPugeLgqlKMFVJVlhdFNkBCQDLcvgogrkE9wOiHC7KgdIOfrkoBZUuSBc0LibJL2HMOYKnXRH3BaLA64YpR1qvNkp3+jZUlK5edu0y6f8H3mBphOMEKh53RLmQdWGw6AsqUW4f5aZo4mHip4nFxGpjDSY2G9tpYvexT1SBgRRnhxesQO43EK3UsQj01UU8NwAUFzuGUt+Riy7vsQ+xJ+28nhCV2NNF3dPFnpWdMYl1zPB4Wg+AdkG5Onl3NFIv6kLZnX4VIuI+c9QHovpcP/b2srE/XexPSL8km3iaeJn4NPH28Z4D2Xizj3pPIN47VxexEEa9PRDvdGw+3urMnTPg6eItyBLMQzc8ukuYTHx9Szb9R7LpezWJfMJyaRNNLmGFTDRtHiAg9wHANziSgUVsotknmim5hKdiieZEGT+Vs00EG2vwgAmoZaxZUVaXKEA9cAEZATAUYB2DPtFDkLXDMlZlj5/bJYqwHAMAarEhp8FuGgWYaFBEdbJLlJHOlAg0LGPlTZQDKaLGGcVmdS5rsbmCrCg8Ny0x3zYR7EsJNtHhEx2RLRb9RAVVLNpkZRJFOFxmuFlQWlmVrWWWoJyA1ipflS0BDV12kHsFP35l44lK0tU9YXVPem88UUGtS1PuE4Diqjam5ldYqgIT+MgLOBXQbCKoYg+QqKHoxqII3YYatAb5Hn2ifkLiDNUmulyiq9hET5/oGdKgSDZpr9yo2D1C/OYyJLo3RofeMH5LAVaU/JrRDy82abRpJJMmn6ZJbp+mldmnaSfQO/OQpsgymzSbxC4xzYDok95ZT0wZZG4gG8h2+8TTRMCefOJZpWcGZw5fOA2J58gwwDug2cSrS7wiX+ORQ7GJo0scZ584whXWb/AkPVOWOKLQJANAhcHMEM5RQHafuEy7IopCDHBZFQOcLVFp9CtXGhJXLAOJ6+YTVy2kVpD1tomh0TQfXaYvgCRuGIQMp7TFWb9xkPj1EpMWk1aTNpOCT4FScClMBOAhha+hjNt8QFjBWAwzVSCcDHNtLgVUKyyLTWH1SRf7FHZ2KUTygAxEJIU4DwC+nJ7DTfo0PoW4g9xvkBFkVC7sqGcJc0PImHBCngFKIStjPgh5H1LIuBxJISOKQpaq2IB1h4ySHZYLDYAVVtDk+rWjFCraBwhH0KwBS1MKCMiAp0jYhqSAtg2tQLk1m8LTp/Dsh0/hRcWkh0m7SdGkZFNKLqWUTMo2ZTFJXBJMNnhcnmT0SUZMaljQk8zoOZkZJEfFNCSZcdctCbskaGjZikc0QUw9k4jwlYgZRuIMgGyMAwBziK7jSWIDp3H2SXBTJwlmMsmYoCXPAtRyM/pd7xYmyTsAxWTUWzJaSjIaQ1AbgbLMsIZoFDS+iHqHJT5hP5Dk8ElfgFVkTUBYxQqGkBR4VUQR8ylQ+XC6EgJAKuJZKkIGO4ekO4eEnUNCP+ujBGBzSdo2AFSjhQWorrWGenaMWD0VBoRuVwewswCi/r1tLuEJRJIzsE0ILj0jlg6bjs2kT5OKTaWaVE1qJnWXOqIcm4fUp15t6rNLfZ494HapL8sACNmmvvqkn3NJfcXy2UPxqUcsDj1OzaWObuwYDz22YBOmKjyi9km3EQmvZ6SedRLoaLiOK+vUtZ17CWi+jqWi435h6gUR26sCCqkbuBW93Os+pF4jxhJ2G0l3Gwm7jdQb0i/aXeqYMk6TLpOeBgF0o8G/5pM7UvHpzrQN6ZYjkEt3IZ9u7dO7FPArx8Wlu20mvb5lm79Xk8lnoumwmUafaQ7FZlqHTKvc5DKtt88UaHaZQgQUpE/2mXYGN7HNlH2mPEFUUrCZDqefPstUYb4SNOsGst02U/NZDyNnagwQpNs9ZGq9wPSpmVrKyUpCDQZfweTR5nGyeawmTy5PRDZPxeTZZHaZocpUAM1n/UpS5mm3mWebGRI8e8DtMu72ZtajXpnXIj7zVrrLjLpyjEPmWDm7zJJ95kNtq1g5YalWQLOZ25C5F4HoSQC2uJ+Tce/XZb6ay/xsJi8mryZvJgebw+hyGKvLmO8B7fZZDyDrXaAceGKfAza7Gde1gGZzALXvYjO8DBG6aeywkzaXQyYANDFZ5CAj2xwOm0MbcmglTD7jFpfPeInO5IfJu8nR5GRytjlnl3POJovLQgTYbJbRZxk5AiPbLLPPOmPqN3izaE6orHj7LJGy0xtWWaL4LIlmm9GKgnUkC/oKlzFZlCkVRg+bpbgsBcWW5AEEbNuQRT+fnb8km8/6abAs6DvBcpClZ5vldFlOKJ20A9H+corNcpl8mPxpcrG5TCZXm+ticnO5hWpyH3LX94Fzx92o3KfIweU+g4MrvNxRnZ5Gm3v2uRd40pvJp8mXyU+Tb5vvyec7ok7Y5tt8d5Nf38QJ3piS79UIWaHFCu1eaOc8CO2RbytUvFDlCgzVCjWHi1Ijo5eRKzsZ+fYyPrg5GYWNTF6mqRcvE1O2MjUvU6M4AE9GgpuR2cqMP3Yyh+xlDj0ZqC9WlsXLskBl0RP3+CkWl6+yNM5GVi/rzNnJGtnJWtjI5mQLYmVLXrYUJiPBSohOQrydhNyMPIzsTna6jUQnkRbAaSXOXqKai/PtJK7kJMInvb4XjDWgZCPJS6KNnCTKXrDvc5KgmUIzkh3CyEpmLzloMt5eckXb5CZOcOpR8kVldpLvpxGxIuLkC15s5HCCAS0HTwClspeDC3k5Qk9WjublaAH5vRr5NFKcFIpe9AGdlNFLGUMbpExbmAcpM6E6ZUYrFvZScOkvZb2dFM0JkgcpmcvtpUgWK6UYqV4q7eSlTgiDGrg4qVGMNK/LqJOGmGhINk02MdKdfmMCj++bl65N0ovDMxcrHawTTnScihEsH3JaOcnJiTqcBCdOTMJyomlPLh7QnJxhNnJZXM/Lxbl6wfs5Vq7o9AkOTurJ08kTTfbkm708w8yDPDOV2cht5OXlhWsleUnmb4c9vldzkD9ojAKszR00MWAfAKyMZg+a/aEnOQ+aC/uDmLI/CE15YNd4UJgBEYCckFkRBkKx6NaDIgSjUgmAQmO97UHJH5QOsgfl4dBrTn9QnskdpIYyQyuvIMMErDcYDWSDWxKDPehwB2mNDoqKF5AL8IDY0VEI0mXahoP00ORBhUFzg0clVHB2kDsrKkOVCoyUiooUFF7aPRyEaxB/UN3gcBVARVrlKuRqY0VUFmnMlAcpE8Fz4AryoBbgQKsopLUwACVrAtXtKKRHUgyKkO9azMn2oMsdeFnooKc9CJ7dM8HYnZH7Msdoj7GaYzLHbA62B5M/mCZW3NzBtIPcb5ARJDqN0WJMRRlVGW04WL+Rc/CEKOAJipNUf+jl48FzEXswhHkG7IAIODwARXCxB6/+wC1jd3BsFiP+4KwW8sQDEGHFGUYyXMwqkUkRaggDxmP3Q01rT/NRg9We5zJtiuAWfgHVIrpYHx0felV56APkg0sNhFwYLQ0Flg62NjOjN7k2NB2ohsBm9KNeXB7cgrJLAKK7+fIHX8zmWMyxmmNzx/a/zP7YaGVgZXts83BsnCXaYwvu2EL0xxbl6Y4N3a8nkYA3EEG63ZGGY7trmMwR/BEoizvCRIB9APTD4+e2R2B/BMa4DlwGwKQceBdWfwRtfFygHiEqRH+EKM0duMN8YEDr609HyMjO63AEneOPkHdAg0CDB3A3SCvAXu0RDneEA8VpXwR8OPdAE+nBhSMg7AM+93aElhluNBSLcA+9+iOcAuEnAic8b3+E14vM8TDHbo7oj0iIkaj/LeSIFGbF7I6ICSrq7KGf0gU2YE3IVLJpJvyOGCWRbgK+yB0RAyMy5r2owa7PRfwRAyIuygw4AA1w+UO/QXXglugRe0I6jYqseACzSlSQdRsUUW5vYo94myOZI5tD/CGEmJVRnvaQ2R+ihQvuYx7C7hBO/hDGJCjcBkABvYo9JPhDtE/0o7WH7GwPif7QK+JDIg+H6Et/+BHFCv4OcieQESDDIUkyGHBft4mHYBxKXgENcPtDz8QdIgooSBBGgvYRKfZQAkGg73YdWJrdIQUyZR8OKdLhAEaclAb3SgukCXGHVGhWBIdUtEpF/IhOroIlS7CEIEykteoPXYAPPTp3YO098ILmIddwyDUjCHE33ByHPY5kj+Nwx3Ec5vi0x2cxR/FHoVncUbAo6RtNRyEEZUGMFOY8HIWX8HRHwXSjr5UfhZ83GBAJqqkNrK8qHSXM7I4SVoDmYX3T0+XAYzhKyF/i6LYSBF6EmpBRq9o8FV9sjyL+KDLCIsKwyAKAWZ0mCi56jyJfbNiW447gVM3tM/BU9QvkpZkYW6VjmSi3Oao/KsVkjxr8Ue9pM0ezRyNzdHv00R0ds0yf53gPR2ftJxxWP/S7CwcGQV83d+BhwNERTj0e/tCvLhw9EeCwR8/+0AeiR89hGoAVWmh0PeRxdARZx9SO+azrst6PoIgVpBf2Ry/YgvSCYrBM63XAgXHWK6aBrsGDi/wDL1PZo8Noax5wm+O0xzmZ4zLH0yBA7zXd7rgRxDfs34Wfw3GX0Ngcr2+f9vN7NZ/kP6lRMZ+j+ZzM52w+2X7ybD4X87maz818BvP5MJ+7+YzmM5nPbD7FfB7m89N+fn66z8/PT/NZ7Gep5rOaz2Y+u/3s5D87HrN/dppBLgswRODOwJiAZR+A5dZEA9ZNMUD3Hj47U0Uuc1YswAhDrFpYyz47dxgNWmJAiYHBDioYotI5KzaIHCDLrqgMlWhqpAkYL/vZQQisSSNFNp+n+bzM59N83ubz9a3Y8h+zLd+rKeQLjdSAYxgUNRFmxez0dZOCVik03b7QTAUYgqIMwJ408QRKtoV4KLQQR1doabbQ6vQOZ6EwA8AP2Rf9OnShUNkWevhCD9pcQVsX/RdHheKxWUwHhRLBmSSAYyiUKt+2EKxggdT3XIp+vKpgt1r0kQlQGXA/o2bSoy10OH0XptChDh3NFSrwr9JQdE8JGlbrYQshu5HTY0dFX5Ap1LTurUWodcifyMSjbiBa4bKFUPoLGS8ppoymTLZMZMpsCvuiOz/9XAzgHop+OgScCE5MIBUOQAHzZFt49IVHFMX49HDhKRzsCnaChadebOF5KDwzJ/x09CEzSmE0EPMC2AGwwRW+MCrAC2rHK8Wh8BoyIRGqLxzUVQQGhxU+ogc50lPxBobJF/1nCoUTz2AlhBOnXl1htZXRtJxnAA+F9cRO4dwspvTCB6Ng3VgWrgGmqpTmCm4tFQa3TdtQuPUCxT7awudQ+PxqM21/PrUSJ6NpzpNnW/hpymLKasrmysbky8YIW/0UKlBs2cSXTWZSBPdObEqwJYxO//caVhanH63A6gIQWxBBAeL6HKKEBRGBN/6w6pSwki9hhVf6TxOKvhhfwoqGDJFvVzDei/6nhBKSw9oEQEaGgbz7EjKGSBBXgsDw4fQlKEAeSji+7KAXQ+Xs9PvjAHAbzLWXLwEXukXPDQCLYhtKuGneTHmYspsSTUmmZFPEF/1SZxGaAQlQwERHyOiwEPoiI8JARmlOPzBY9MNSHj+3xSpZtCllZrFFQK0oWdbOrkiIrgjaS9AGomNcEqQSC8jDFoH9PCOp5mRx+rl0QICooJoiCWS6gRXOqEcYFVLJ6RJc9IO0+kXDgp1LUZE2OX0huehd+aJv4xV9CaDoXfmi5//1lTEASAxBuXzR4/9FLiRR8I0G1qOc5bDlSKZ8mlJsKcWVUoop1Rb4Vs/DlGZKH0qnPJMtfXSloz37iO7pIyK/jyVM4N++6E370mcG3LZ0yC692tJXV3rIrvTItiCme1IbeK5S8DlWgEpCKMMCJsiuEyRWf6efjgegoIPBLhinWnE89y+97oDqAQISCrioLr250tF+uqKX05TLlKcptys3ZV9u3P8pN5vy+lZt/V5NpaFicWFf9XXpSmMhW2nylaatuoqXyyrNQ/36N42V5sBDpYVKcJUWtpVWV2klAAPEVawtlUL0VW9+VJxyq4RkJOSoochpqBRDhlbcwfnixxsoGewGsinj1MxTbKXkK6URRSYw8fSjUhLUJXWOthKUMwQyXM+zFo4VqZKWl+F03gEVOY0UYRx/h6/6ElqlgoxCmyKsar3rZivBt0YZGBTv4iv1iRWh3GeQMzQ6HOhaHwRuJa3OKfD8PG9b6RoqXTcXW+npKz0l20q3qaOpk6sTjR6LISnOiqx4IDOBTEpmkGj1iQrIomJlUbzBbiD74evE6PiJcxvqtIlEX6eAcifYw13YOokWJnIoQlUOVqzAwgMwkCayq9OXTFPsRVHpC9aLelroGBRhqPAFDJrRUcuOrp3QePjeKDBGMNJQ9fOgps6msq1MrjJFQPJVv+5WGZ3EqDlTqUNlPXFfmZqtPA2Vv9qGp+Yrz2gsnhnSOPBXmV3F4lx1ca5YlytzAsAIH0Nlbhv7yuEF2bgAoq8cE7xJwVYVRdxx5ol85SwoIFcooKm/3oCvLAgKPkgRLciHoNQDvh2NhorvOMFagW10oZ4CqFyC0h3VLqdyMDAYNWubr9wENWvoZP3iEbAMiqjm5SrenKv8dJWft6mLqaupm694m9TVjWaQMyveQ90ImxH8NOSuAJXeeVDMmhDFGxhVFx23oXc2Har67mfdtOP1Bc+q73YCg6LmlgN4MlQvkJdauW1Fy+OtSVc3JpC0KJah6guR4MMoqz/Mh2JRbMCAAjiqFrptCyofFuQG9TYkAPRDRuX0zRn83ODDYlCXgzobimpWFB1OXzdBd+INPVc3gaMCYXmgfoK5ZxOYE9kV0ZwYW/q1dqAyYFOHDV5wAwPNIFdWRHeUr4Ys6n3hC6yww4IOoa301dat+4q32lzdOlRxGVm33mzdblODqwGDJ1ACZFvDONQwRr59DeMdbQ2Tq9hd1YBICLoABF4VMwHhXUBM4EFg1Q1VDSuGQljzAKCoPxgCIVJ1NWCu12MdNaAL9KhnDVEAUI8N6QT7SWAkSYZeqraiX0KmAJxQNiawkBHQIa8gV+VuAJSTe/U1SH/aGg5bQ3FVuy8URo2RWQlVRR0wMYfWPQAlnmRreA41PFc0YHhibIWn+vdiUx+m7q7umFD1NYe6M4bgzpete3B1D7Ovu4bYHjCT7RpiO+a6Hbc8FG9wMuAAGxGwB5jsaKm9o5H2nneLTWTdbzY1uhqxIOhR9BppBYm2ibQzGAlkVjY6PWJF0wPdNcLbSI0HxQLOCc6pirerEf5G5l3xUIQOH7CE2VrvmwFROOoYwwxt1EuP5gJvINbZGA5AG2rUvW6Ngkk6ygiAnsBREYhhLOgh0RpF3cVYiNKUvFyNfR1q7AGdHzH/6V3FGruWhPUm9nLYGm9Tk684eQhEG+q5t5oI01zSJSLpHJAYYacnj2rSUZ3wjoni7WqCd0kwuBL2tzX1FdBMzb7qexQ109JdzeiHrPuerP2Q0exZZ7isc1umEoea9d981MwEls5PWT3R5+s1h2VxNSMacjhgRkZfs84YWRfirJNFFtQnS28QuUBet6sZE0DuaqOvpoqrQjvg8IAbZLEVRmXEqiCTTgSCrR6mLJnJVVnIV1mCkm2o+uDVV/3OU5UQbZXoq95NrRKhFXkAJEjFoBwBKcpAeAkGtSQtLLGtmpPVAmJVcpiA+R6q6NP2Kph1BSu47j1E2mYrAuUrt4wA9lUKtjZSmq1SbZXuq36Ptuoaq7dBq3TodVS4FwSw9Ap/utq8XMXn1CtWYLnJ1MPWg3w9CKKHroUHYZwepFCQxvbnIF2U9WlZPagm5DaQTdmXit++6nMlYHH14AkkRtCB8XbwFyIM9H/3Vr3wrgeXNFR9KgPJy9cjYFNx6BA8wgS7OvMfYVdaLYTYXD0wIxw6RR4ha2ZRuggyYT2oi7gmrfo8AHgP9YhfWlGFBBF96Ag4RBbFfVC8NaHZ6MxDO+PQVewQ1e1tqIfeY6p687gehSFTwooM3S4fpbOrB3Z8R09KQhxPCuqBMX30ogz073Gb+umr3hkENsVrqJ89jCMSYdZEbqYWW0swtdpaq6tViWZqs7X9L642zKFNR3AjtGQjDJuGcdwIndpoVfYWgZjqmlZF/yl3bbSrTPxCNRNVElvxRnkATJqbVTerzLEhVw0UzSzKLl+cBsQk1XSWbtS6CmEENbRgY1S9aTQ1pqiYgKx8joMiayIrHooogAPc4hjJ1Ya9bfvKLCqohSDMms5FTZetpnNj09Bqukg3PP9SvDWholhpmraLqK5gODeNmyZqQTBXN8lKIyKbyKwYFdFqoiIYWECV0eaRkhSVg71R071Rk2uordACmwVzXCvYNbaCQG6FsWtqJRxIiNagSFJU2a40NkWtD7V1Quw2zKFNt04NUdn6jGJxUE9RE9rHCNSGi3jgrgjXujp7a6XvGFxt99PU7mvHTcPax6H2sWHj06cNsNuKgvqMyaXP1VYMic6Q4JevfcWs0TEe9T5z7XEhV3WL0CPGYY/xHmqPrdy2olY90TTUnhKu6DrMYo1UL/XzNbXruZSKBwsVVwr9QID0A1NrLygelxx4rmArtlH6HKH2ugUg7gP01iSbepp6uXrRCFh9vXSevBCTF6WgeAyKN/gZHAzuC/11UVFBNPyF4XzpTv5CqF+6Tl94+KioLEzFl4b0pTsT/Xg0UGlcPV26J73CCoBxnc4u3SJe4VC6QCdgnF2hVl8vXVovwVXJpfPYpRe0F9ry6nk19Wnq7es9Yj7E6xO+3hHhd8cTdbozpow7I6RvvSS+Sz9MfX1rtn2vppHFjNP0TkujUcQ1GvsAiOQbTaE5nY4A99BI/6NNw+GTRrNv+u8nGu7jY4pqtAm7RiH6pvdZdDoCZNcoTgDkR9jDfhuT0wCQC5x4AyUDe7WNkmuUoJEO3/QkSiOYyigrr6IIM3lzOpU1yhVG8g1R1EZg53CNDvh9wB0EVaMjfGEFquXiGhXUusyoasFM0/RdBcyBrlHdAVCojRVv2wg5mmxchqZ3/ZHQvO6bfqqnUW9D+/qnaI2ujLyna/QMpo2mTabNprFtTL7pfX/MooAEKGBWBtlc42nzjaeebOPZN57n2zZGLkOa8wCoN+jmAZCP/HSYY33jdIhisw0dg7W9ceY2NM6ZbyTQB3qXoHGujIwqxelnggEweDADw+waFxSMqnFBA3IprAhbRR2v1elrmQB4hgbjJ2VF5D2baYtpq2mbaxsFp19bBuwgG+CybWPXNiYA+6ZfDmr6iaC2cQKZVACaXFSiKjZVuX3TD+80/ddobQsrCguQxngErooqUmZkVt/0OzNtQ3NsUvKgCFuCum3Sh7bpgxr8sOIFlBEZwlUTyurJta3DcEdJHbGy9TQqHmBX2zbYxvOFFnwLI8I1jOjLMAayLUwOa+EA4GZbmH0LM8WhhXnEgA0zA27bAgieAcU13FRoYVV7OCHbMFBDVOmoEJ2upk2/tttCci0k9oAnUEvC7N1Cdi1kAiAfF7m63LaAhgmyws9jaOHATbum3yJq4UBUhsK2BRTS0MP6z41b0FGDq1zTHqbtpkXTkmnZttxME9cE7Ss038DaXJMR5Ey3azi32TD1yNJtk9U1WaGyVtckxAGA9tN/rtpkv5EZZ0C0TZJrkkYA+yb4ulrDAJCMMSgZdhEAkgOYaH7J+9Ak16ACkIdF2QFgCQrDpQE2D00OegHDNDQ5DtRUjgqt4psU2oAY9FJQVgmADND8CtsFFktHhTAhSa22aSlNncR4koYhoOcsml5rNOkF0GxD9On/+QWCeaGCzzApZtvkNu0w7dO04pv+N9+m/wEL2BRvIGKlYKovOsvrNhrYwE4gEcWFDpB1A14RDGjrEClYOlvhERFXWGEHI8PvorORHt5oJah8IBQURnikg7doRJcALzRkCwK1BAGgYKynrYTGA1Cya0Vm/7XZavpvMVpB2xVcqbYi8K1rjXF+ohX9z7b4uV0rXcmoMliG9J9kNH3e0fRRB/Zqm23lsq3cplXXKhXTmm0t2taaa00Tt2ndtj661kcCsMOGzrc+Y27F9XHrWEn7OrSuZ6Raj6hQj1HpSrZh2u/ZtY7B1xGb2Ou1ntGVPYc6tJ4zOrofaGHsmFovGK+97ICM9Hm7hrcpWq9VjTf1QT08TTttO8m0y7aLfLu0ly9s79vFWHSuMLFrF7rg0i64VEInA93ItAtvKSveyG22XWLb9TTtado9tFv/827TNz+xLS2u3ei2+8D4urFE3VWyaa9v3fbv1XQyfTR9cn2KZPpsOpu+mL5ahHpf4236Znow/WH6bnp0aEPf48TFYxNKpiff9d8t9DQWGno6QmHTs++Zyu17niL7rv/Uv+ewgBMku55DA9lY8Qbj6XtuIZouph++4wJ56MeGSaUfkn0/Di5DP/S5aD8qt6Efuh3qB3ao/dP04nsZKQ+K7Hphcrq37SV4dBl4odpesuulkunV9kquV1p8x9Mt2+vkep3J9spDr7yg2nWtvteNi+s1ICu7Xo/qeq3F99opAsuheJveXG+0+d7CRMDIQ29JavO9NS6md9t7d733bvpp+3mZfpn+NP02/fXttOf3ak7yJ039C8XpPzk4ae4vf+rHHk8KEZDdSRFZkdsA7AWJMAO7ZpyQTofFFvbUj02caB+cwnYnVVC12ZOaP6nHZs7RnJM5ZwNFpuhOnsmejBQXD7jdySu5k+EEh+xPjoSsODdgpAHYkz/1FMKJ/jy//ufVyVmS03/QcHLu1Z1cYKqM/uSCanKZA3Dl4eQS2o1EJkXNrkpXpdXJcg8n18ooCXVh2G7T5k5uYk9+mnMx52rOzZzBnoHcqa6EiYo7w8Qgtxu43+4MM/kz4DH6GdidgRvg8mdYQ7RnCO4MCmj5ECPMwbOQbxgVVD+I9kgoawCvrALs1Z6hujNU6KAGoYYGlAJsYDeU3ogVIxjQbUXcGU6YPgO6MpxhBgeZT872DC9zPsy5mzOaM5kzG+TJhJrKyooICcFscwq0JTYPIKBKp9DsKdmdUqs7sRCeWALtKZc/seSZ8zDnpzmLOas5mzn7cPY4UTbnac/zdOd5nua8zPk05+1PTEnmfH277PW9mov8RRSrvWhyF1ZEACveYIi9aHYXVsaL5uAvwpshF63uopWHi9Y1sr1ocxdt0V0Uor8o1AaygWywFk7oq2FsUi6Ks7iLIrhxHwBBaWjHWBVvYIXl2CDSRDXby16U3UUZjmWoZbiTm72oOH3X46ICfkkDoMFGgUo5wCisNNwrDdUst72ougtrOgC8egAgU7UKuId86R38ixrYrUFDK3ZC/+kveqrlJ5Lw/s5srtFck7lmc7G78AXSC18gBRYGwgDTDogDAN4yKshUPOAGnmwvHt3F4+gvHuEGo3cYBvEw9WIY0iTPN8gd5F5BHu7irdiLg78Y1wUXBxgPBfK4Hr84TmDH2en1PUC5VbnNX5zVbG4OF/3uYjQxF1C12YubuRZzreba/LVpKG3awRslkGUB2fy1MTV3bcwgOQLjrpgUD2RmkChgY6gENP6GG/XXFlaQajlAI2QegPkGB9qo2hYKTIe6g6E2mqq87LWJvzb5PrtrEwKpxgTGRI4BiFpvcoBTkdkPe223uYK78BjmCjN7QAbKBWybv4K2X0DvhIUt7ohcQVkR8pHv4Qpx5wpOBCeSYgUmArsBbos9x6UndC89B3+FPINEJwREfUDU453rK+QclCGgNUQDWiMc4BYFJFGTgBDX/wtyhXqAPFBW85cejr+CVgNXLZc+j8F3X0FinODLc9fDXLu5ormSubK5xF7C9pLdXbKzuwS1lbh4ACsqfYJOCGtJI0jO9sKAxn/MGy7Rb/dfuBK5BCNZxF0iswdUxRuMCPIFEpErRbmooZQdaS29JJBJs1BCwRDSb6ACGxC1lEbI7RGWOmYTUS9Pde0y12GuT3MVf+kp0KvQARKq+l9tLz11eOn/pb0KtnqXfpr7KkEZjYerfDWlno++ijS2V7nNVc3VzNX91TfK5jrNddnrutx1XZe5nvZ63ua6/XXrVHMzyrxjYH/pt0OuO6O17pzN9fr2tM/v1TzJPEfznMxzNk/2T73N8eQiT/NczHM1z808g3k+zHM3z2ieyTyzeYp5Hub5aZ7FPKt5NvPs5nma52WeT/t8Pt3z+Xya5+2fd+Rkn/fLPF/fbnt/r+Ymf9O0NXvTZm/a3U2R/U2xkb0pDTfpu3s35ueb8m5vOvxNnz24G7P0TSX7mxpXe9PlblxM3XRlc4/mnsw9m5vtzeRupg1QPCADaxsUbyRadTfHCDj8zYitW/+auRdzr+bezB38jeFp75DtHQ5zP8y9mzuaO7k7TWTubG7xt772DyzulpUAwd2yswdEd0vc7S3Z3ZJndyP6bim7u/EK/C3d39K/z0BUW3oB2TZ7y2Xuw9yf5i7mruZu5u7+7tNE7sYd6btv7u578HffYb5HNvfp7pOruS93X/Dxae7b3vft7vu+zf369rKv79W8yL5o9S8Khe2Lsn3R4V+E//P4Gs1rMq/ZvNi9sMgApPkXj4X8C7O/fXF2Ly7iXgjml97GenEj9+JezWsxr9W8NvMK9hVW/9LvSLxCngCrewXJ9hUO83qY125e0b+itNu8knll8xL7EvYvSSM5vARoXyLuJZLcS0o2r8O8Ps2rmFc1r2ZefXj1EqbNvE7zuszraV63eb3s6/Vyr9fr9e3t2/u3H99+fvv17fe3P9/+fvv37cO8v5n3d/P+w7z/NO+/zPtv8/7HvP817//M+4f58WZ+vJsfP8yPn+bHL/Pjt/nxx/z4a378Mz8+zM838/Pd/Pxhfv40P3+Zn7/Nzz/m51/z85/5+WF+vZlf7+bXD/Prp/n1y/z6bX79Mb/+ml//zK8P8/vN/H43v3+Y3z/N71/m92/z+4/5/df8/md+f5g/b+bPu/nzw/z5af78Mn9+mz9/zJ+/5s8/8+fD/H0zf9/N3x/m70/z95f5+9v8/WP+/jV//5m/H+bfm/n3bv79MP9+mn+/zL/f5t8f8++v+ffP/PswH2/m4918/DAfP83HL/Px23z8MR9/zcc/8/Fh39/e7Pvbu31/f7fvP37aH29v9sePH/bHz1/259ub/fnzp/3567f99fZmf/36ZX/9/mN/v73Z379/299//to/b2/2z58/9s/ff/bv25v9+/ev/fvvw/57e7P//v2zH29v9uPjw72/vb259/f3d/f+4+cv9/7r44d7//325t7/vL25979vb+79798/7v3f25t7//f+w71/vL2594/3N/f+8ePNvX/8+O3eP36+ufePn7/d+8evN/f+8eu3e//4/ebeP37/du8ff97c+8ef3+794++be//4+9u9f/x7c+8f/36794+PN/f+8fHu3j8+frj3j4+f7v3j45d7//j47d4/Pv6494+Pv+7H29ub+/H29u5+vP14cz9+/Pjhfvz89dv9+PXnn/v59vbmfv78+dP9/PX7j/v19vbmfv388e5+/fr1y/36/eev+/329uZ+//792/3+8/ef+/P29ub+/Pnzx/35++/D/X17e3N///796/69vb25f//+/XMfb29v7uPf3z/u4+Pjw7+/vbXNv7+91+be39rm3t8BPwA/Ab8AvwF/AH8B/wAfbbPQ+gGtH0r9yLP78bPM7ge0fkDrB7R+QOsHtH5AC2I/ofUTWj81Ca2f0PoJrZ/Q+gmtn9D6CS1I/ILWL2j9gtYv5UHrF7R+QesXtH5B6xe0kPkbWr+h9Rtav6H1WzOg9Rtav6H1G1q/oQX+H2j9gdYfaP2B1h/NgMIfKPyBwh8ogPUXCn+h8BcKf6HwF8X8hdZfFYHWX2j9hRZS/6D1D1r/oPUPWv+g9Q9a/6D1T+Wg9Q9aID6g9QGtD2h9QOsDWh/Q+oDWB7Q+VBhaH2379p/Nf/7P3/6v3/6L+S//5dt/Nf/1v377b+a//bdv//HtfzL/0//87X/+9t/Nf//v3/5v3/7v3/4f3/6f3/5f3/6H+R//49v/+/8/AOKteUY2nwAA"
@@ -0,0 +1,9 @@
+package passgen
+
+// Encoded and compressed EFF large word list for passphrases - eff_large_wordlist.txt from https://www.eff.org/dice
+// Licensed CC BY 3.0 US by the Electronic Frontier Foundation
+var effLargeStored = "H4sIAAAAAAAC/0T9edLrPhAkiPXME7ewz+Ab9J1cJEsknkCAvwIgffz+8ZzOB/IBph2ZRb2OUDBT2Pe1APw//j//5//xf/6v/+t//B//z//xP/7v/9//93/9X//j//5//b97mWVpZZB5zYemiRiSxE7msOqAb0gbMIZ69TJH+dWHzFFHmVO2Q+Ios0kJOd3kDWumUiowrJv2MluWFfrtrPEaZC6aFjhRNFX9kniBhFR3quTYKtwqOdLNUiXAk1JNltrLXJqtvSyLpjrIskQJxwQMh1QFy8cp6YJebqmOsizNZLluUpWk1HwMsmjNCQp7SFpKT7I9ZAlrJ0u2NMryX5NAr/5rwfQhi+kgi+VZKjFdRy9LDWkjIEWWGt4eGJJs1Hmr64RyfEm9SUVwq2kpD1lqGWRpVeM1ERGyUdTEHVfLc1jKAFJynIinLPqQp1gvz6cEG+X51KXqOjkJafuynMDCKu9QyWKQqrAX7OgAP1COAdbhTmyaKvSzrYM8n5b5N8oBWzFLHeUZW61qnTxzhqZJgNdVbYv54yyGpzo7pO43y6U6Szmnh2y6DrJpZGZsmpaLsEoHqJNsm+nG8G67lNrJFqIO+IZ64V/aRtlSLjUsg2w5hV+dHEPaOrBrks1UUZz/MXhjivgCmFogBwvZZrmltZNdZX3Inq+HhFXxKX8kHA/5KzZJlFkK0yCKHZPEWarlUjqJczsGiU/8BombziaTxC1bqPvRSQwCU2EOk8SgySsaGSIKQv2X4otiFF8SQ/qSXx0kHpJkGSUeYdtRZyOS9iExw1J+Vnx3wTfphO9Wwkq9/BwlnrvMWgeJBvujxLrntu0goTbENtY8SmxHSO3oQVLoJX7kKqMcYlpr7uQQJPchSOx4dXLMaqMcc5C0QGMOWwv1ulluhZpeKJ20AtYiLPRgZZ/kQBFgfoEdLI9gZQCw+hzBk+0IC/LzIQfq8BE2hCokfgvMHzkFGR2RL0fSUq9RjhRyDUsnR0ZVPrzxOE412bST46T3ZwzPoDY4u6iOb1tevRwtKuy0grDgf0ENBNxhboV2W2E5TbLktMok6a7VgyTZTA6o1HwEtKZkVy9p2bMNhPcFDCycabUc1l6SHkEcllGSNgvleEjSzyRp0/gMZR/IEMe0qfWStqirw/2vwEhk4582C2iCgGiCwFooO2po2lpEW5PCIXEgVJ0cWXmcobkiy9aBuJFcWFXTK2onKenPKCnl6i6k3NKCMpNSvjyRUpOI7iMlFJ1RUg6pIhlTrrvaJKl81DzMFU33BLSlMiGqSqV7VWM+lSQl0V7Q4xyws4cYYWfPMW/woIY5r0jyGpbC/2uutBp2tQyz4b+m0c3+17TcSh6+GqyhWUs11PwTFmf/1N7BJH5ZK/AmMjoVfUknqRYkzzsgZa87JNeeP/h7ZEPQL3Zb6TqjLCR19wS4ajho4CMIyvXZ1bSTbFV6OWXZdZAzl4rCfJ4qkf0WmA2EcmsU5uN5ouZtOjoLPyQ1VDdVw6/SXpS2jo5FOxAaiHe9B1sExRVMV5q4oM68pAmTUCR+GfL1PE13TXTYsiy7kzeKHAniYmHJtQNGfFHkTm+yOsGw4yH/NRnlv6ZrW+okNodqYteXIV9t5TjkIabSiWmSUWxr3qA4uToQ7cQCUsgOWUOMeRA7ZkEQ7Vh2CdaJHahTdjxbhO6e0RLYweyxAz0bMAdTornpbBf+mqLRtgPe5QOByQXW0QENgILIm6ECd8CrF4MlKIa3xJ6IEFrehMMtZ7UTKzn9Eau9lEXTOhFKyMkVKhWsSkiDlF0O5FPZNfFbHlL2qxPvNcpLP72UqHpOUk4x2VCSy6lLHaWcwdh1gehACGmUUnNiM1SqR6hUC6tOQNY9Z0lavRlbvlIbcqlGVsgOpDzQKo5SM3tL/Dt7qRbaMUm1vKAjGcDO/eqlVll2AuJWqx5nnYBpFbTyZKqu5L2Rs7fClmKgUWtY8CcYwuJFDMZMlpqNLMwe0OsMC/Kp3cOttgoDNDpDd9XWMEe98YJG0LRoB5L597a6HZoqykjb2IC0uiNgSw+GkWWrHFACKtqaVvNmcu5kh7zUbsbRoLPb3BlirpO8JUTvXd+CfnfXXt5S0cC/NaHyA9hQvDU1HeTNThHqhvLTgdRR3oGN/kCS7SFv9MHvjO9HQu3lIy+Up48YldCWfXaO4D4vKPbySRynffJLH/Kx6yE/ofSzzKiMBARkljnoCsg5jbMsLzRtE8icxdabJV2puVo+exDaWZAmgGfQ6AaeyFWQXdI6gKD5A0ZJaxmdld0JekMSjJUGZzfmjUZOWV40cuoqkUqopU5yPqhX9vB0JVQqJzF42Dmid6UzeAxLlXm6yeZ6VaJ7g1L4YtTaSR0k5k3KPjmpalS6kPqzLJ5yVS3IdJN29LOsUko3y7rpMMuKBMC/eOEfp0kzZgUIAaYJaetm2TT2s2xo82bZNtkUfzcm97YF5My2cRgxOKOlDU5uZzjh1ta0Vu1m8Ux6qV0joOzMO2TIMLN86uR4qyw5Xd0s8YD5WDAC7Gc55pz7WZIkAQTmXQococ+S/mb8fYmXqvSac0b6Jfc8vdQGAEYgwJSrm7McEdOUWISSOwijZVfEOXki26xLw3+bFeXJZqU9m2nalrwiqsZ8NNZXKIdSBQqh5kTbUZFEdkhAsOyQ9JjF0jSje1D32swLG7CtiK2ZosyZaa1KnbDIqjQbPHyl5hyhxZaPSlVN7TF7eSloweLVk6E0lBD5TfyiDJSX1n6WOmckf132xyx1B80IZUUdqVViYDmrcH6dnHjOgV1Er9K1RkS6oWGc5TfnlwxzlJlpFmVdkXVRVn3MUXbQQ6FxsK6y5foyllOYSC/8L+euh/5jF3RQG6NUScs1OamIL5Y66N0vAxVVFnil8sJXz2GOeoRCNVQMNAbjzJZA8TccZ8c2asD3peuNNhLd0ZBe5THHcEKxlGeLsFN/8ff3VwyWslS0XkCsScwxz/hsUMqHWnHiPuV8Iu9irv0ccytIl4aE6+bYns9+jhi6T3NsyG1XThVfm2HUMAwhXlSkFqpMbMVzKXNyOxKfLTphwLLUfs4zC3qeZ8Ywo7kGLFKhWqLrVglxmvPdHz44uJ2zNxR526J2c96oEeKfOb+GOUf4+5hzhENJ0q/0c04r3UsrK2hOa2R+e4Ofk2KqTuLZA4LpMwjiktDew5mEIpxTkQA9epyuac550+uQRBaU1S7nF/6mNS+v0s85M79zrjv/BB0IDE+uURZXiLrRFEKXf69uziY//Zy9DmTjLHvOZvmDJoXEkxWdTK6SUBNHZwWhrYL2LrPCue+1ohUDeOLXmo9+5kxqJLhyS8vF/yurCYh3ZbmlGp4t9nN+BzQ8+WcR6+b8o4Y/HtQfJOFjzj9XPxuqBaHu42yqy65lckLTpspmBUjfTfVXHS4o1900wdyHxcv0w07BAjy2MCu+ywtftFxcUlyBm6xwBxVumi3EGCTVx2zhgFl3I71gEg2UY7wmondAaGYj3UTCTVymxCDe2SIFtrKsDF9Gz+c6iYkFhv7aGdY/YCpExjYHzwLLL00OBoNomiT2ZJnwqyPBQ5zzi98DbjWswMyWP7MK4pg/KWEaAFZo7cP1g9laKO5dSwvyoSXvQ42V21iDrZWiseAvOgPDQHFHS9d8SNW+QyqQa5rbskhSZHzz8RKgEmgc45ZcnbxCgoVXRTUg++wq0FzXHcPSm9QBhFFtK6p8WzfkTXs+JeYeSJ+eT7S97flkGWrPZ86pm9u2XY+5xbmbW9x0wtfXa8GaQe81zC3GNW+T4y/SvcX45HIWmaHtbDHu2RJNh3xj2YmnJhowJm6LseilJJ89nPA8Xt2MpO5n1h3ApgR3K71m9HEtvXY0wiPYIciRlhLikJCQZc7gBc6UgHFVKzHLCjWEtXDS/5jb72+/CMYwwyIzBln4OwedFplXTPOsw2KXQjvnot2CUXC/cIyKPzXgT22lX2RdA9TW9cJX67hwCivLsMh6hHY8Ftng1I7W6rHIS8dFohxiwQkmLovEBZMsYItSaWZpsRVohVltIppUfSwSDyhnC8uN8CD+5oQ4HEu2lXE4NPf4moyLHKHkiFgcpxr+n2itBxBkDLCE6gZa6RZJEnt87QIsGodF0or5AZApkdbrgWINlZAQaB+5gSSZAxIoJV1hM6E2AHNyqIAcBe7kNOF7alKj8ok0SVWVhq+chkXOO1OA1wjEpO+xyIlon0h1g7UzVImOGVE4T12nxdc0jA4VLO4CmzvISSHxLfVWeeut4vlz1sbUMjk0dsDKf29BCG2mA7ZymgSFlbG2NcgyEjdJTpJEGi01Ly8wfaIKkG0ofTDma/UgpVCnYugOfzfkKbu5RQymiFlWWOPEFApJNoTfkq8XgmEdY3KSDblnmf9PTVXty5iCduYcHY3RNM92s1wnQiueCiwcVjXCi4pg8D/zy2r2VKnsZsg+u7rFNxPzfccD06lhkYIq9Fik8LP3C9fLHBBtdD3jIqVoZRUpXMO8SQWpsuRjHkAwqCNepd7kV0nOFl3FZEF8qtScWJfqIhGJUpf9Lmx12ZkjlT1wT4Ik4rB+JHr1qWwTB5Kyw41dVxMkTGWzBS309XAjyUkIhNJOaHIUB/SeZOHIvQPQww9mpYs0pAYLXVvYArXCfG2FWd0t4n5xMRzqCCGLe7uLQuN+wLhgXSKwuLwlMiPfQYxQr27RFX80ql0ToNSA2GgMM/b9nKDB0IiFk8UX4xdNBY6rYcZIogdGf/caWPzHqrPwDKgqZHC1iCmqipaCUtgtuzx1xBfdF/6GxK8Nyy4xLDoBoyY0szv3RqBy5CNEWjzOgDZn594HwA2mpHQ9VXxzgaVTLSdaOqOEBGNoPWDgLPxX3XWT5cs4PIEVWzLanV1sQ9EAsnjvYiFXRyTsLnbwnyHiO6eINF7vCBaGz4s+tuMYoFJD+RIU9V0qRzYgtwM+4yOBAeUUZ1h2XVexbtlVX/xaj29Rh+ux7Pqcll2PsEhENPUIBamiR4a6hbK7JWszlEvhF3HSt6HQ7fqmlx9u0YCgYO0+/AQi3oHehL3J3gQsrouYOttzXkcyVh8QDqOdYZoBFvhFvoXjfCx7OGHHTo94sBOhCKi+FYQFG4bfSLqYLaxfgl5rz1588kv3HJEZ+eUBzseJf7kwZXO+MyNnJlc+wYuigOTP6mY+3CNYdsuENs/wuS2vx7I3WG7HQZXjxDchL5oh7VrVbgl0JkRJ1XK3BIyHlpD0kHEJKcmBMhxsQZkGsNUJhuGCTTdBbQQrwxKqN2ChyorWOVRfRFwCdhfgVDXUWu6gPBYWzPAOC7/xsURZO0oBjEuUAytGPchxEbJB8zgnfMuuyJEoCFH05Z1hiaw5N9pIvDWMNR2YtLoCvMdKIL8nvwU6taLgcf8DNt5hiYrAffCBHZXEr/EL11Q4gov6rP0SNS37tES1DTPPEQylHPr2gv4bBTUGrF72S+Tu3y31MDoiTTiUxzcsUGUUH0tE8x2xU9UtMctrWGLm6sAS8/LCNynUEvMq5uJZEnPhWCLmumsZiSx1Mbe1X2L2IGGhYR2JbMljm9mPdEtsxwmNA8PcnoSQXheMFa4JQaEuOxyiDMGCrZ5pybI1lLFhyVI4TrqXIJZ75WEiiZi4OQtJH0tGfcr34viSZ4lwAVMd/v3o3C15yTLgm1r9s6A2Z11DpQW1RYcl6w8m20t+PlWnJT8zF06mJW/JV+ZvhuqaNw4WpiXvahRxuVklKeGNcKGsYlF7yVHwga9RS5QPSCjajmHJMcoG+zHKiXYPBN7G6OIcN8tGs2F1I0GpESiQs2RkpMSblHqTX5rN6eL/UgQBivWx5AMBOhDt45mtdsALX9Q7LjHhD+p3Pg5EcHCy3lhdw26NvOrkiK1+Z9ng+3Ew5Y4jexXPx9GqG253lh6n3BF1Zl8Wry/Lt1q63aC4D9E9OTXyb4j+97aZ0x3SMxddv8TdyiVUBh57o7dKM3VDLblpH+KChK8D7RvA5j1YPkxWOJQWeTsqcyItyoE2yAkf0qKWvipMc9b/nJagtrlV9qM5LbGtTkzRcOa0NFSDtGoqSL20BuSCsztl08pJVk5rW8L7y7zgpLUF5HuiN08tZSTWGmDuGVZx155h9TQjs1vJMyo9w+ZJlJ4hMcdAPBnSM9hBrRiWSoV8K9zJmShHREIpCSdfp1vxOpY2RVF2VipTPW0mtTjhrCJziQxm/uZwp+nfO2h/m7G4pPStP2SuVjgEHJwxlCVHJlXJ8Q5KyelOjMKOanT2VTIJ6WZh+ao1j3Lh6B14eNqVdtzJU8UNVwmJTVriniS1lA0asOo/hsmDs+KGym34xzHbjV4yqnl5TpVzp5wqUxQqb/Vsf3uJOcVqUnss+UTROjl/AqCgcIOQfxnsE8Nx/D3dAtoIYzNir3HJlmZOSkBMBflgaclzD1RxWB2MxrgB5yxyvSFb2ltxxw4Vdwyt/wtesemynDh7BmHSYe7nJkFYQczYX5jpghwwCysLvpm3TUDPX7NM4cUlW+ECS7aiiLJV/RmXXMLmgS2H1rAg9sWbxXJgJJ7LiTKC5GCO1Oqu1IrBZm7Lju/GL6PaEouRszV/0F63dDd+LVWfKINhogNEXBv3bZb81sRpgPe8+a1eJd56Nz8Xl8WW/BtYYH65TPZY8u81LiZ3/2wybyao9CYzBqwkh0rtF5M18v/KPYzFKJw3EgMaUpBySHL2yRibgF3dYhxnmaRZsY8ApnABNdixHVR94YuhpLGRNHlz3GHywYyUJKoVJ7fWBS14dWF4afKLem7yewfKYwoXf69hMZVD1xttIvqM1lTQqNot4AJSfSYA5jo++yR53ypsTUy5af8lV09Su8UUMy/Tg85kzIVNT/9WGKd4BYLjTRjw9r3cGW76Dgud/nwVPhwGErGWZmEeFiyJK3wM9DFguGQBUwALR2HKhIQBBMAzMKRX1BuRNKGcDEMo522gsIcEeukBQ3kJ3BqGCR+XWRbkHEeMXLHGNz0WyycoraLEDwCurGANW2jzs/ILtbZ4eWir0t+2UsQVKhpdReP/VmHJascM9eMIimEZGOLSjlORwO04IyOFxVlmePPNwMEZYtOKrK5V7iUTMF1vNBrx4a21Uh+LtZ9+sctduc6KdRe70BhNC+ZMe8YIv806LG2mwAcRBaQtDbP7fmnrGtXhGpfG/fLXuLQY0IhNS4tHSCx3Ld6rhi2eFiq0fJEPWrWxQSPRaWnnrGJoAdqJddpuaWdY+6X5RKbd85dmt4tGGeSl2coQY5TU7KkfAOcLzaKuDjYBfKve2dYBL3yN30I30WzCSkHL2rhQgr/YRAUUxM7erDqw9PYvHCj79VhaOfullcIAlyq2TsC8BkmDs8tVDrFrdMbQgXCdiKz8WVrtlmtBOl+YHw2EtDli+HvFkFZkx3XMyL2r5jNKOf6x+meV+c8q67hCznkNsV9l29S6VdCSrhISmr+e5IKq8VugFyMKSbdKWnRYXTBhWiWtGkNOPZmNAGvPJ8ytcIkymCvFLsf1FrucVjFd9R1iB1bGlSuoDIK9NOk6OUH8wNBeApFfJFxqWYUZ91jFEj4Vtj4hQSR0xQb/KkzyeD1WqTKuUpUSECtXYsZVsAfFQHvfNKzyWaPaY5UPYnRhxgcwldewyrWIIRbXaioHiMvGgGRav+6AUUBxWOX3N9L9319OWVeVJadpVXkyciMYm6NVJaoNq8sqwkTk4nEHVh+rCqzNdzNOpiOBmaJzqMOqswV9QpntLYzVDCfmBr+xT9OvusiqHeDJb5zwrXvMif+vcdVFpegKsxoq/4e32uTEw70o6v4AAvl1YqUJX76/2VthJMxKbwLlm6lyuMfhUOaALuHckfK6vPAvooTAYAwJ8V3yqjDv0xkyo6zXzRjNJTPw7P16EqTRGhapOt0kW7/q2hZ1QNLqis/Rr6qnJgKKoup5Z81TFjj0lMUz8SnIXH1KixV/VRDz77mDm72VzGXvnDG9npzOOKLnBTOTeBOUOH0Gr2Uk9CJEmgx3MXqG5P9TqDQXPYrP6P26s2xU8jnBzZhQz4wsfGbKVK76NGkrMRf6huZtVUxZHqs+kRabyUpHQLwEbN903kzh936tHO+sigXRVf/S327VyDIVdfMwkjAborIQR73DHMOCpWwnbjZQhIKMsidkBrXRWTuo9Ob6/82uYVXKh8DzKvCpbfSJ8wP+/4Hzh7iUwKqHyl0hwRi6IzCfjryYh4oM6XNkhtvn9zByFayWT6sm9gTIwhTumpqCxA6AApYyEygVZFoqOHe0aqoS8bcG5kaCE49VExzMazZJ9WbhF/5iMsOUAEE/t+qJ+eeqZ1Qm2/kvSc9I2VsS+HVmq4RClzA0pUvGXQYyRdoPZKUQwxuBPusOKw0Llj0JHDQJKLqU1UVm24zUt+DuFl3MXStqrBFAT3JMOrwVA9P1S+zWu029KMIBF141nzD0wngcJMfb7VOCEUPx/14rSg1MxFIDhWtX9WnruGoVrMP3IAENUf1XeatX3tEZq9BXXBbMNmWNrFroTk5eUkmyUeknPJEIFJrtVm2sym+JDa6+UbevwUl1pAu3XKkb8QqC0TJNeB1456qQUHRGx/LXSm7GlAPxgvzODctJq/5UtVC/jFUnyIwY3CQsJDmGZVoDDxZp+bJQBjCT47EGNFNB2Gb3a5ATXgY5d5Pt6NYgdj3WgLY1UG5xDQtPPDgypGRI1fB8Boz1RrBWdP0S1/O1kZu99c8atnEN3rxNa4hh4xrkzepjDTH2a4it6mMNB3SOkCDCtoYDzVk4Dl0dbFjD4W17wJi6W0OiYtpmVPCQtv2aVk4uMN8Cy/zCHe6cryG5lbwoylzIP2EFnjEf0q8Bg1QHmOJYdVyD3W0xicfEvuWNDBlGku2iEgIApRp88BO4ar2SbOzgQpEY86cHsYP/zdDkhsKl5GENhYI/QI0cIYQyc4S7hrKIrUQ1BKf4hhmMLJGtRChLjtmcNLRFJPbVe7sXGOYCVwl0R9MmG7K+3EtoayibyYLswdgslJDQUcIOVrSg/MIfTGEnRzYBocS8sKEAW7ebXBhABEzSK9pZsAvWj+DJxKNNUMizMjEohgGD+cMAnmJeNsHYZYHVZSfxXhrEisQv0/UfY4LwZMrg5KJW5NQbLBc06ySuwLMcIM29Nd3utLd2oh6U0mSl2er9P0l1hVJdIcRIhZA87Wo2N4HWxsldULhY56SV2q3Yh8O3oizXX4T3/R2RgbFUAdOXWHHihfcdkrImvf/9Z7OCM55hjvqPXaOznG7C4v3OtigM/f5+q9Xv79Wv2TvKvISoD8gdjmteqnGEk5d2aKrdinzn9+rWHCK/GPpnWuX2AYA7MlTIZwe4hjXHcw+pX/MhIY1rPiglSVLY9GU0FDk5yVqGlY36OhJDTq6AkVNO/m21X3NeI5zJFHueQJ4mhyu9Up4HkEPSjZUaif1lzoY1eJJS9bxJPmn2I4hf/r0ea7bXtGY7AhqEfs0ojP2ai2xIK8a1elCbC7itWJuA3hufT+rWjPMFkBV7rCbzuJqLpk8gOT3jdTNOsky2UtEEmwQ/ouls04FE1xtvIxRrX038uMVNfrVbsQAGoyeHYyZIagTgM6ymwrIGfLY4kWCLjuyYM5IKLDBsKge3v5yxOfy30kVWHS5aMk5gQQgJRZ5HjldT+B2wSdatXFpaubS0WnhW6ETO1YCePOGWnCdjkTdvyHsS+BfeXngx3kkOdOKtH7RGxqoCxETwxqtbLSeEIefI7zmtls//iewawOb8MwJfYXlRIWol5uZ4Mtj5TUc+CYY/xSPeVpQMa8efFSnAXctpbTOGEKgNjfIC49qW1xxihILHDZKPX1IFU/O2vK6Hz5Haqv0KwcXYr23Lrf5Z2/5YG7Kgebq1yOWsx9rojYuxAeOXoGD9WVt+rO2EvTPqzwS4R/tkocK2r+k4XiOwcuDejL02hziPtZUXPnVYGyV7H2vDskV7a+3Wj9izWz+q87B+NEZdb7SRyIz5hLSi4SJS5UpyoFEgosm9kuDk7HqVqD9BvmR5UGZeZYs6qBgPHaoYkh74jHL2wBbxN+ZZO+CFf4fYi9iez1HFzj0nJQm6OIltKzCCmALLniuw7pomIvvJm7mZusfLVT7Zjp7sAnwC9ItCBB+IIBUuMgLjNQI4ILpXjFVK5bibjBvP0KpqMFpx7ma415Q7laqpx9fgGcXlVWp56CxXp5A177ju0inGGpPyVgNDCi577nWJEmxQbN8XnXTJMW+YCTm7Rl1yykdYppu4VsrHBRUcdTBaK1epejwUIwRdb9lZkg2K16C+bdgrd8FHTP+5hOAEPYCzbH9U46TPex4/OCtQCTyQ3Ovzma1Oum0zI94r21RAytuo23ZG9Ny6bRTHmHTLh6Qgy6BbrqEck2MNS6+h7modJ8yTRpm5tDFoFNeOCGWncc6fSeOqtohBe1WLVw8ssKeLb92RMTbxHlpq1HOXVAeNyisbHJnRzty03+IAoumPxueoMWzB3SS5Jo3B13I7jYGBDIhNrzH8BPuj8TVoZE6OxBqWPxqPSWNO2x2PfCIE+b/GEfzNEIOifpBXY1vhNEf+nca3lk4PCXHQY6bIJfHFv6VcE7DWiLw80Blj2K88lY8v2149eOgFNqMegLxe0M6R4T94FEGP2XJYkRvHorClJhHOcuK3wEYoJeT00CPUzpcC8A1LTgMJ0vI4pe5hGZxcQDWUuePcpWi5SSjTTX7VldCL6nEGSsVAKeZL1y/RL6FL2QLamuPMHzVgDWoTkeMrsOuPHq332xYmTbJUigVqkkPjhO5RuAELFqKsMmpysaZBEycAk2Mz7TUteXUwqudGWVUynpXUtPAMMyxj0Em8zjpyHWxDuNOqYvQRBKuXYGuvXIoZ1I9rAFOu8GXNi4XkzDCUG8iKq3wQH7jKQ9ZQ8s7jZixhyTuMSRNyEWM+MqxjkFyjpme2RdcvscGnL1DYxKs2Z4NQz8aYblw8+RKbnHgcNm4UadpafA6aKOs4aQqbj5M0/c3XHUpn10DGBPrLOwFc645dFNuYZtGlGMG4IJagGbjhpol3t1ivCXkBCMsOyDEOmkrEPqSmkoR5eWcpF0AAvCdh1OQitBMJr6XhSVxPghoMTtRQI+1iAUtTzccM814IoGDo/FI1RUJVDsNgDUWCCji4D4WPZ2xLS5AKs2+/v0DT22+6uAkMcxWECjyNq+mdr4emN0Lwex360BM5e4ZV2VuA2CHxy0KhZjNUrDNEPdFykFS3GPPWFASt5TXoGUpetdP/msQeXwTxv+ZzApJswJDyzwQ8vXLxigQqvPOCAJj4IvSoJn5rBoiuDjYAmumktnlf16uZpNWhDoAalk7NsnXK6aKWRbjqe5NsZKcnG9l6k1DcuG259lpe4ciTlnzusrUyaTlDTqy9hWt+edDCotRpKcinUpTlF1gDIkQpEWxVgyFepaoeKD6l+jUZN2Goqq8OQtPypmlQF98etKolZAcQIz+tu6QcO+WdF4o2lAc0ycqk7aQw7DHqW5bGXhJEtdM3o4tVNnrvBN6/5bw71DfvcnJ86wPd3KRvtS3Kqs5Mb7XL+wewVW6SE/zBpFo5TV50cFIf3MzSd8aQ7J1j84HGO0d0YD+y1EF/JFZdgceJLPqBoDeC6gRB/Vn8IBnZ6W78LBiv649LSkMn3P08GFPxh5KhxLbSSGxfZ3zJ+8ve1DWuDt8s2wXW7GvO73X5x66eDArofMSum4XnzW7H1JZQ1Dqw+tAfLZP+PLNfvKQ/u0QdAA1jyZ+9HUiZwIgEP2SpPwFaeUWp/MlJjWXrJ8Pl40tg5JSElDwlMWBADwQZY3nqQgEmsDWwj7sZgnhqhDOa1pFQGNrT92SoY/QnGAKA/pgJTTF0GIzqI6sfn7NQK6/q+P1vDAelmzcYddk8EGO3+XPmuzX44SIRoRldoRie/pwt3jH7r4USmByVHebkhO0yWHUFb6S45JvNSZI46A8XjGAm251hFc0S86aaFCrktxoCX80LUrVvQWqzmqTaPRngp8wWlvEpc4tolZ+ycIg/gVCClkqU/AHhAVWS8Kw0dHLtHqzq2mNrTSJM+GVsT261wWAJR4g0yFYXmAMtoMYAyq5aqdEkAlus1+MpK4LJMcVTfN/syWP0j6fE2D0lFjgbS3jC9KHjU44Qg1hPchGSK1OE4ikJrTBwCbpORI60nlzSf0p6iunjKfSTR6xgqHJ8d7PbgSoFFgoL9hOzLYmxf/qC7dNFf6nOWcxT2qJIt3f2wc2XwY13Nl1HolsCqfrnKT/dk3fVPXVVk9g/dc0m/VN1jvp4qq74QD0UJLpGxldj/Z81nONTj5Bc5eCu+pfUm/xq99SjWfdkW4gvk9m3Op9qHL081RLnqk+9rywZndFLM62EUDoA1d7Z+qdyugkIb2QsiU6OkFB/apWI77J3TzTQ3TPM8DiwrPTPsK5RRwJTJqyK0jU9w7q5LJmza3iGZ1VNHXAf8Q1ad/6FJq8n6e91bEJI2t+7sc8Qo64O8JxLC49niAfUKoMV6WqslNx7hiQMVxJKbjj51YEEeRoSB4yOAZEMOPr6DEm9vHG/4hlSWF4XlFlAv8QmJx447hA/Q+KoGojFz2co7Fj/PEN9PDHHfUZZlrACt0PSCDwzghllK3s4J5IaltfN0Bs+o4QIOy+syQL5PRRmjoOrOM/ICyfG530WgSRpLA+skUCb481nlLLj4o6btTg7W8RouexYqXCCmEVe1QN4wZE6ux+Vl/OBuGnWIBqomiZH0/Ufs/FmlxvKJxU+Yuok2wHyzretd8aizs3u+PwUVqaIViIqMqMnInw43/2MQa1/cswOwLLkk1JH+NbHM4YT1GrHuwbxXV7jM2auKzyeMZ/9M2ZDRYjZQqERNKrRhfaHZ7zu5L4EC8jPeNFLinY944WmfXzG6xTItT6zHH+eWf888/Z45hA79NTL45nja3zmGMOCZj5jZ2l8Zl8d60ku/vd2Kae16eOZEYWcV3zigAyQTUcgmrWb1H0i4ZFMMIQn57qpONnDbZaHf573PQNAL7s5V5bMnGvKKNQ511PW0fF2/rSANifnSuEGkBI8LCWbk8/XRwp2PnMpIQLY9npyjs/7BgGSKiH9eeaf7skp2tPuOzBJ0GE/TbaAdtnuSxqhxVaDSj4PvVntniZIcJODNiku/TTe+wTj3mX3T5ML5cmEglfAMj5Nl1fU9UuoorOgLwPRG4Pjmg8aeDIbTJU3/zz99kcq8IwoyTUBDimMmPrOI0ipF9oBU0WlGJxcrhBipJlfDknJPDbqRZ1TP6in36D0KP3SI67+XOPNkCZadtirWDEFVsby25ZbWOUibNo9zR0LmmiGM+HJCeumhS2sng1gaJssoINFhUUUQpmV7qDxcNFP2HpnDGf6p3llsHx0lOCfKBozsykFY9ByqWyRSNCCgXBbggx+ZnQiWKKH0q8iYdtSc0FJaZvcJQWMQWwBPrVy9xMUy/yzydxt8nzqn022aZOQeEB5AEPBAJbHJlEmuMMbXkYwyrU6uXqiQ05UzZ9CC2zJoZFNofAW9OzjJgd3lB6bHNA+3K/jkH6jrB9go1dpM006gHzk+rPJ2W9iskHJeKJ/E1s1AbZI1YjZLTAs+Mv+dRNLWvEXvRf+YsNgkzJuUnc1tckJmqLNT1Nu0ngb6iaUKq/dJu1XH5u88fm8+o1X3Dw2Fes2XV75sam++k3DBsf16DcfrmyaFGNaIgYpIDUspds0mUK/oooDox6SerIL2rVMm/qFZNe4KVewl+kmpQ7OLqgcWt0XMph2kfF+U5tDnDbkGoyAHWEJqzqjrAwYt8BATsv5OWw+BZ8cAzJXCxulTSt6AiClBDet7ey2IKlOW5hnHiTtucBbhy2sK/bbiBxkgF2PLTzruIVN5guehE12tfpLJd7QtoVtizoSmDEgFyDH3G8cHHWAMmzhOMLy6rdgK628lV0VSOLX+o1bZt0Wfo88bPc9QVvk/MBRrfMJO74uKQOGsPMoZG5lIGvUQMu73RdpwURaWxQ6gSTywQf+lQJjbcmHQPmXpSqqt85bVMXVMVsMLCvR747eoouTAM8C50KpKOcRe+34ywOTwN9r2GKWqjZu921HIJkzDRKEPxvPX/9jNji7RmJupQO58C3kb/jKy3Rg5IPRyrDFtuSijy027bfYqqYJEJKnTas1p35LYvF6bEnqY8sSxy1LLa+QHlvWMmy8qah0W6bbOa48tgByhKQTSDlC3R9bjs9h40R/77acZB22nNYc5bHlpPhsjy3ntdty1mvccn7yDA3IXdZyfl79lvMWYR6hy5QH3bJpzxXXdSTgQqUt2zVsuXh5Q/d99hvGDwugKmLQEKlPemzY86bcCToGEk5GwZCeUFp9d3GjjKODwcDK46ckKDsmq19w4AzV0XAMvgaYeaJTILJAmYSEv2ldZXXyCutAEi/HQxxPcQMFldckhaoIRUpuLkeBe6nSlJdek1P53WGEN5E63p6Xk19G7z7T7+xy9Pj4/Y5wtmLJsudKNlPpze7fFYorXGIrWahSdXB2wZv3hWSmy15t7N5W3EzVWxWQ6C6qrrClisiqskYA2UybfmD42jH8emwW1m6zoM8J37dXZjD3JuibVcJCjMNm4ZBFJ0dPh3D4/7uUWTgQSU6mAMn9DCcsl4qyZwHJjGE36iUIAptDwjcfUMpvBCLnN4sfCI2cyDQEewCcaPUst4rvm1rYpdn84isofuJjszb3G/fsR4JHqyl3dDcI0rOstGNmBjfvkm8VpC/22RnQljYUiIa2vckiR0YL21y+x0latAPRAV8WgRYiqwPU0WC0+By3FiP6hA7kgto5bO1AjQWuls9pa4en6eBs64BXvzVjZwBg2jZrj62VvdtaqZnfa9gaR/SPrdXSbzwP/WdrUHd5+e3y/f5pl/le7HSW6uCkugLW5Xe/8JJgw+6XWhKLfP7ssg67oCWzbpfwao9d4nPYJeZNE/7Ufpf4pgvYaJxwZUWzTa3f5YgKH48jLy/8PenDQQkZorlfaZ1lGx1jdILVMBKTl1JpEatOopzTTcrN0HC4Jm6yA9GVLj+bO8hiStISFXaNK+0yI5xhQkMWdRYbnK03Gq1xKWRn67MqdfLzSYUTc3sSzqHATEKkmSIfR600Up6m6myX182qpJXWcH+Mk294PhmL7WQWqqcHbwDAqna6fUGrTQXMVZEGW2PqvHzIBxa0A1zTLucuGBxMvBMkeUacZ2A0gaW6QqTh05sAsKvfxeYMc7bigOdNTCcnb3U9SCSSaNL1S2xy4h7aurMw2rqrrNSiR/ACU2UA/pNgGYUE8yia/QS7Xf6gfwS5ht3vVYayD/ZAePz3H/un9quDM1pjjdzFsOMDx8qOf28t9bHjAp9dSolIwVJhj/OXiXgnDpYBd6lz/pl2qQvXY0YytWtwUl0B7QcJK/oudNHPnexSTRGblhAMiEZ5Xo27/GpMDXWOhzf3+0gj/9OZ32tESsqyKwklV0l44y2YS6GCUbKr3ylP5mBUfwYrldY3RTVQP7MAjHKcTpgrX8kzEErhOKMYDhjvsyXJ+aBeWcSedLKoa/GgKEn9BpZzKhrCTJmoYo9d5cSndrtiMxZfZgHQM49s63ZdN+izS5h2fX6zSJ/IIo2YMuwaD60ANkwaT8ydgR7beN6RjBgXwLnjjuxRKqVBd4pg4LsfkvA3Sb9T5gEwS5wAnIZ0YHDNAo//7mpHqMOulsNSHMsB9FZRrWh8dpwuTLuWwFtIx5vVm6DY6I9sOY1Ek6Pf27zI2e88VzgSWDja8/lnbyBR8HnhE7u9YTa2s5Mc97uP9P8XNMM64cuNqNFZvYbdOy6YO1qBiYwGqMAEF9ioZJ6CzecVICfqBGxBwHtvxxvtII5fQh1sNV3r3u+N8g4AyiSSXA/cNANekWN+7AxmXGgXpHhkbI0KiPTFIo3bHTMzuSZAWAT5CoYK14w+WB32Rpnvx44L+HDYXJEIGN1rKX921MBrtrAOPLWD3LhWP1DlJCA/rpXbtVSifPu0X6ciEC9nVX/g0LkrTJ88N3CTcJMalukm5fjH6pf90s28WCjXl1WdwtxOy09Nf8KiQ7i3WYDbA2vej7Bk6F1j4JG5cnxJvcmvDiTxmojsI7lXS8kaZ8/rJpWEk/Q+rCEvVwc4Hlw7ClvMeQz3pSh92FI27cPWJMkUIncA4zWG6JutN3lrFw7ZdMKXJ2FHZ1oGJ9sYjlmXEHUIB+vC5EhHjoOHiQZOMYtOjrdW4FZiOI48h6gkFAMDMU5SyKpEKlFM9R+jZkuhfskvXDglmK49SFSCwb1TKqdB4ThVlh0GfY8aJP1j9tSlUtOCRFgPZYelKInORD30Zi4L4SzQ5ZhXpVZGBL+MKXgyuGeOLBfHiUgx6id3UeFTLnSNt0LRSKW8wpe5/XpHglKN4ThNl1CQuj7aIRb32/Jx1kaljJaV5K2u9b49ASuu1tbb7RZdpUVuI4MZg9YM06uQ15CU8Kt/Qk6PcMraB3Y8j3DmtQsmVf8Eez2CITBmyldIxmAWeIAOxC9X+LJrdJbqTaoOoUTKsTqiVvhpqXVywkg4y2kIJdd8ahdKabBbGvWrIINQk8jKI1Q9plBDUoN8dagtaenCO9v1J7yvP39l7v/K8pJI0DoBXik8dQA7cx3/SohzsHUiMZVXD6ZGBb9R9K9EOTXlP3/lGP5KCjUbsMHXv2JbTsPfWzb2rxRu0/6VltawaAdSH3/lLd1f+eg6/BW/beAv71nQx1/54M91h+LCTX5qj7/y+/v4q3pOf1X9PPLwVyNf8gBe/V+1ogBKMv/V2v0Nz+fV/w1bkU/3N2AK9DfwrDMBzdXfkH6Gv1wIKjdef/7muf+bl5deE6BQYOpv3ja14e+95/M372nAyRg0FH/zi76CMGjT3xz9AHsHdj3+5lj//M21/5vfQeL4N19+keXffCH6CH++sAD0N1+8eO1vvnw/72+bgy+U3ax2fzEnnf76lDRe49+2hiVInG5i1+NvW3P/t3H1j8DcaCyu3d8WFtj/Nt1g1/C3/Q21tOFve+mcfx5/G0LFjrsD5Mdf3KX1tyXfXyFppo+/LWn/t6WActBSONXw9xUUf1+YSACxRNH/bdwe4504j7/N4G2pHpxSfVXNGfwulbHzjfbxb3trClG7l8x5Hl+SNrGch5eY5Jf2wApdO6R76Sxz/1JN8RoBcOLxUj3/vHR7vDSe/Quq8fHSs44vNV66B5KLJu1fWmvUB+T8H68Q0/gKMWOhlYRvJIH4GWmwj9QKk7WHEFfK/YsC7OPrll/3/xf/czwJYroCtYYFiC2+ByfJr5AwJSFiDAv8ZCTmKxSXTAFRGwBuAzOA9HiFCr1aNXWA6/EKnzC8omrSn/GV5CyCSCXV7pU01vGVeKlb6V4px9i9skTpXjm/rv6Vyw5PICmaU/dqay6PV0vbQGFoXW+0kYi2yQmGZFFmkzVb73fnA4KWLsoadYiyXnPbRiB3ZiLfaxiin4HB35zTI0qwR5SXdv4iV+T0ewRw54wkxDhEPzBMBbjpBCWHJCR1ks1tQ9D/JrcZrp2C5E9SI4OIntvjjdskERU0YgYsG5V85IbwvS4Eo6qlPsq55q1DYxn7+4a/+2I//K35fESxtaP4KOjrwVv9opSSQWsX+aYB7yqM3FwZ470sOESplHPw7XGooxatfRQMdwfAquZoF5RNkVZvbqj0Ud5YMI7ywytm+uhTvnhP+R4Rc72orOD24P2AUTdZrg4Q8UfTCtiQFy5hX4ao3LG48ZqABeF/RN0ylLlOF3Vrh0L3I3YgmLphNaKLeiDH3em01R284FOHqLmiCUGO0grvLpqiVt4euow3u/qotbqJ2hbtor4RdfUnY3qSgv8+qrtJtimG73uTkQKuA2+TrtfE26QtSBqcXQMEB1jWwpItLPonhvURw1P7GJ70PHCtmYg1nBg24UYaSjpSOrz8WUWQTyiwCBe7GKIs+MbrEQM/xzzEcKig/IXDT9t3YAXfUKF7emiO07MvJO3Q5uQR3xZSuElBCHhK1t+GgHKO2o4hhkRBCco8xJDTnxjOgVc2Pi/HZj2QUS3ngyP6GKqPfW6Cyh7q0Up/b7AAmJYc53YxYD0P33jxD9IdqyB5ecEQBvXQ9iByESm2mZOp+mVVu9iWsA4RB6RQzoCenO1+HSe2xVi2p4heETPEPjYvru2lKHhDbDHKfPWRd+VMfFqQXS0ZNiAiz0qhsXa2OZa9jy2xOrQkNkbOMfOPE81pIuG6BFkNhz5iQ/42Q51uhoBZWD2/mr362HCX8QiAYu+3U04A31QG89jei0IwWxGzDgQaP40Nb092dRQwmeJlLqo7kmFCdpPak5TxkMVfHSPJKXQgn8chiw6Hv046OSLTD+HWLbQ2TVX6Q7YtV/yFTyMxSOoO2Q6ZDtmSltCOkQzTzpuU48t+lcyHAM4uV+E2CFiOAQHc8ybp6g8Jh67jIX95VHRwAjt/s2mtsPM38/DwIS/NkEE7+BjVwTd9wrP2Bx/1mQ6Jz2yHro9DYn0cciBGxyFxIBhcPQ52+sMhSTa1kYgSAVIV3iXelTOQVJ0cs13UyjGk/vA7dw4K9HeAzC88SL4SDOt7/mJeiS1VmAhLrjWMZChBIE/UHbgYosAsRhVAivUR2TmBXVA/ZFUYT0pjPIZ/SMqMEY+rQLeGQjCkRGoSH4ek688h53iISd1zmkAogjocYnPU9UYUJ+NCT3eILXDe1gDjm5gXJDKWG9tCgvmw5bjif0jigBSAoUht3hh8iMVAjw+JHg/LGSnu164D8wf+Mcus7JRlIHOFdgaJYG/eQgKbPzhjdEhZMtK48GosuEy50sMl2kaMEcSzrhSFvcJb+w7BYPU5HFJlzShbWJnN+TXe7Gc47ovRj+/KLAkHJodUTsmdJIbsFrd/HFL3/n7N8pBqAUnpDEvKPdkPIaNg1coE4HDAVyfi5cTDXznP7Q5pb5TVt1pYEMqfwLusSNrRHXLNiD0k66dDrmf0IpEloWJmXsXS30sbBNYwEjiTZz5wdeRllx57M7TMV8GOvHK3sye5hiNzu39yRM9BBke4VT8R2dCRNdPuyFFsxBfZ8DhyXKcjR13arQ6mJHuIcThyjK28/hz5mI6cKMN79UdOODhw5KRV7CZsgnLSa5atOGNPQoYueuQqZC4IYNpM4Tqnv9B4oa6C5A3HnUkwRwDxsytgZ46ux6tZQGpOrlWv0wkW80BK0IYUTyWjsvFeTxbZnHCYFEZ4AH84ckYBm46cb/EQMMSS4gFHzmlWOfA/KZIr+wGl0dlLByeuwOclQTj0debNP9gd3JwwMf9z5LPnmhbUjCJTw73GNR3ZZpfzcsYQ84yYfsnWHTwr5otht9H6tV3ZhTjjJrkzN8TVhiMXCUt35FKux5HRMeW6U3wBhDI4/X3sD8AjHTdhaeP44Mg18/LADgxFt9Y8Hd97MkdnaNdB0OXc12VCwRKqGxDnd4/cGJ3mJ3bJeJsNdS586z7cS36PA4fEj/wO2h9cuuqOu67x/aWjLTs+rz9HW/8cbRuPFv1iy6NF6kXtj8bj04RaYKSGM+qXXBNJlfK6WUOz6evxx70e34Hk6cCqu6c0GfzBPewHzoePB8YxLyRDS6ugPLQUlnBK7I92nlq7oxlasGb3fAgM/ttxt0GtsJr2R+Nd00ej2PBE9GreCgdNHQg8LmGBaaxWHd8Vcii/LtiukjZHW/vDh0vHd3AEYyiOjbnYAyui7Eu4D177fLQafOXtZigVrYZ0Qb+CN8YPe9/9cRWNz+G4+DTi5DdLMbnIrsdx1b1Lsuz5T5LtkSTER5JD++QSeklSuqYkyVcNHklO6J2vkPrkM8TkM8QucS80sT8b0r0bmL67gaPffSGxT35FKAG1j5dcuXozqnPFZUzSfHE9ydvH6zfJCMb76pMKrocCaKn8G68RwMFoUspsA+7/c4syEcKvWp90qQKA4yMB1c7JW6ekm19xBqUYNnWlwEfVEw9cS9Uh6aHoX4mhPJLm1Cc9d/08kto6JDXKlQCvR9JS/ySFC80fcO3J4HEz7nSQ1LBAo6oNAMupS/pGFmCXJPHiiRGLIMiukeOskLRLQRd8sa+XAuqMwzWmkLSqpomEBxJSSH8FGlXTmvG37kNqS1SkCzD431b6hJlIHVKLOKrVJ05ABgB61+QSJADOPdDOG/KOiLxzks21wuJaWHJJ7axBIixZUbuIaKxSM25ZpFYXKUSuuqRWD93G1KoFTRXEj8WnVu+aBIZAohZ06Yo5/cny/IPLWLOUUP5kqVOe1V+QHW9Wx0wxELv6POP8/JjnyLI3kOhKhXfIabpJK32eY07blGd0hBAoy3OGhcIBr2OoF5jaW+gLmNqthJYGrBRdJyfuA9kbwSs5aiWpskS6Wl2GE8zaUns+FAhb1bhdBIVWEBAGc8oLr0ctlezk7unNqpOg1pNcXV5UUp8XjbkOeamyZfytkhR/M/IeeCK6W9Q/OcRHDuk15pB4KPyRX3L9yXHtMt/fz/E6Tsi/5kM3eeRD05B9zjrm7yn4fIQ65sMfMRpy4i79mJNfqD/kRPHBLqeA4HCiMOYUc8aKe8bCKQYBppq6nIrWASOBjFxIJfJ+zoE3kW/6yKnmHoMCWx85XT+PnM/yyLjYBnflDPl+gCqfEh/51DTmU/0iEZKqkyNGuzfL6csQ4dMLfJfP0I4hn7mUdoz59IN7PUhR/i8sASR09rS7LDjL9iefdcpni5QRHniPdigd33LvckXLzGuz+Qxex0cM+9wQzyG3yi1mYFhHgCdq8ys5SEzlRdKsVFhZpFQo+FMdIPlQKKy8yggkZytdblUNf3kreU9CizyeA8IcgwuQUYOCX6qU/fL/3GqUUvE3Kn1GnhIxIwHyRfvcKucg0MGwacqtehMEdkaebQDLHv4zN4OjZ+Nfu1PC7kDxKUYSjipzq2VhiWu1aIxU4LCPJGc3wuv8QV7BaqHSIcawlNxsUbIzv1BYWq2YtJPsIb3g9udO+I+GbUfwPqE+8ltil9/YA8lvVIq3mizVEWEBGqL+VmMWEm+dmSUGJGJtkczzlyy/XKmFWKnkGQuS5UsOvcltmldPk60mz/plt/OUkrkZ5pNkzQOhsqndrH7Zj5rH5qkerKd+yTcWmLI5aTHS1sabiMCwxvAl202aW+OLpTexL/FYQT6CDv3ljRdgr3C7zdX+0VlYByfnjZdr5NtlL4Yk5mniK1RgyQvPW+1kLSG5qOX3tdzsVuJjpWQWFk9yb0ZAUCD/MbfJXTUn501a9PS25nlxl01nnlEFgbrZr3qQ+ea8G8Psk+TUdDOOMm923UTPWw8CPs7a8+nso+p+ooBTqe525x8vKiaJ7hRnXyTN/hEv5Jh4AP0qS7KgHypRqu9P/sQ+/zyzrQOmmJLqROQmMxjmv44Yg+Sfa0PN+7mObOgfLs7r829O2mH8u074+it6aN2fYRkd1W5Sji+pPcnVn7Kuug4A+AKMOhLQgp0u8tWdsknCV60/fZntlL9ySOlPibLoBODI/nFKhD9+eznUTy7sw2C1azwpGqrrl9jgpFBhj4qwJTlkQC8uL4Qm8Z7i7pS0Cv7y1orHKWmbzu86HXTDAkvcBD4lZZNDppu4Fq9KAOYjHAhSqnbBZi1UvvZcEOZTTH5/Qw92ScdzgMMpp4UXwnVe1hBekzlHeGHCp7B6sFUHQv6hBgXPafalWqnEPRtnV9HyZcGdxFt/VDp0DcvNKsNtwrkujRUJ0Umobr4EDKtPsUUjgrDsLBS27NzpOMXWnABYYjzFGBV7eWba6yMoDhYziosdWoSGc2S0LFeYKlEvYgonELUE2pWFiM/ATURK/4DFa7hfFKGGH0oDQ1HwndMvgUku61GB11iDKIXDyDAlAvEwc1jl+Haj4e2FvBQKpoKc2SrJJ9vanVKq9Pjq6oCkKtVLQ6mMfKkoMFRvprRzTbzukocgyRD6e43w/LdGiJOVowsBLTo4qR0wT+d3zXAgy8gQvsVC4KOXznDVzintZPjfergTb6YfN2YS0ucdoqfw2+vtx5PEz7WeclEa9ZRr2XVBkLF+2J1yqfKLanzdVuADjFK0Bgp80v5UvthNuLpTFxQHXWpI46lLi0FsPNXbienU1U9iQYlr4SSbqULv3pU/dWP3PZwag7sXo9bHqbGCv1ED9D7gTVKv/tS0hIi/aCOHrxzVqWnPcaWryaVmTk18mQcYKKkPRhdOoQuUCDs1ccQ/OTE5/px6jqcar24fSJDmigVefHMUVDE1OIwMdwkuXb8MJRfd+0FTofDpHLId7v5tbOZILpi4F07ArsepdsCUSw2eaqfW5r7g1j/3pejSPAxF32rOGu8Z/zLrTi10r+Tp1FLCgf2cL0MKc0Xm5P5MWLU7tQoyuoaK/PJKC1ULE77ciIS+R7XWsGSpzu4I1Iqo15aCDLw3LR/9ueeZf7Om8DOxWPEtAbILGhTLJro7e04X/pdzl6r4X3N/7iZFRwKLahRewEtEiKMslDnEeOQF5ZSQClHQ2kUp6EOiXxNx3hfE8SqNFVYrtpigTrFSx3aQZHYk91Y+8Dpbmc4oze9u/bILmpe3YNGrHDy43D+IFMHoxSMuZP6YKtjt5xUxZQVhxz06q24mP59ETM+AfC8BpKjb4dt71KrhUET8V8YzugR3B4J00BVNS9T7RXcyhFzrnk2g8xO2KGU4fZv9cca84nPiU/H54IN0xpOQZ2zICz/6DaR0yBmbSYQmUqohDRtCe1FY/8yy7H9OuJv1wKc+zrzlgTeR6XqjjUTWbxBKyJNd3ZlDUfwpKKOYE/enP3l55ij4E8OiDjAdQ+YfVMEcQ8X+9pnjSx5njnnCyr960cjxYnMA5CkxsIPup2XPjzMnhDtdw5nPJVt6nKzf+XR/ecUo4BrPfBY++HJiPivoBEjsVkAVzobViKSPk52JC0uffJIKPV82XyAliTBen4gMdbgRefqtNDSBMpiNs7eOj9c9zlz2/p51Y1YeWDydXL3P0wfMJGWDJ3xIEYDtciCqGNX1BqO250mpScptoZkSP4LYtwW+trTAs8YTpFBNyFq/gvTMrY4n3xxFc0LCak2GzPkwzT+f/PlzIjQm/pr96XcCEGjDeM6FCnjvZCJBUGGU1c/uKsapBxo9ErcMdlGLq+6Ubk1LHUgw/CNyaGG6sOEyNL8wyQ1kmPB3JWBiDW73KSh+pk+0eaZ+gQUZ97JhZJODzm+JC1Q3o6Fdue59mrrIUE/2gS0+9neaHkHtJkXLQIIWyzQJi4qpL3BBKdvKdNBTwtoTL/6N6HdNz/OChbLsOUdnFmaGrqiUTENFGbOy5w81KABOFaw1nnz0AN/m0bpfszvNl2KJvH7py2x0VtQN/VT4wj7EtP5iLGb6lsAAvSXevr2/GPQzErEyeZp+hHnx4d7weFpYeW3ryc3n0/hwCsECumiyGzFYt3Cg5lo4TlhOC/siC+j8LWRqlQPmPD1CKUhb3sCFGIS3LJcjKjYRF/2chrnbaVn8TtHT8uy9hBOkfJ79zWswuJX5EhsxV3Q/lueoB1QWXVnXwEqBI7zDjFr+RhlYMyZvXsOGCkpyEduiNyIV81MSA/cUXkkO5gtyN8tGU8HDy7s9qNWKMhHzponW7hcPwExQDjOfX4TWX99aOM33WieStLknhyZZqXaL5oNlDk1IOEijXLnH6Dgr2m5eBI0vkyn5OiBYzSO3Fu8EzE9TQfbn/GRgTr/JmAybKE486vc9Y2TF0w3NKPw5GTXzrM31X4yqbkqPKzoNALaKnf1mYdj9gnmSttK1hvLpG42w+2YBzW+lcb9Q/iZMhvsiebLk+ff2upV5cZ7jHeM3EpdmPl4j8+eO8CfeRiBVUJmIPxc8/pWlozD+hC9fbcd/utISrtI4UVPKteCk+NnmiKlo40WGaGYbBQnOpnPMf862Pc4W43S2ePAxRvw9B8j2czhHWf+zxTffc3+c7ZD+bAf763YcGrsTbd54UoYZldZJ1ZsZ2s+WNuZV85ubHiceoj7bGWJ/+o4n4BpPiHQJfTSEL68jmSHNXQ4DwB3hsxk33a4OTAd8mfDN7xToSS5CqdAOFQ2oH1I4m52oJgD2Q81QeiLibwYnPRSlefUnS3SmtICo2FsvFKvGu5vqRLJLgMeFHdd3M3ZwttEsH5IAOUOiyXZ2gGs4G+UwHyf2S09uEY0Elp7L5AjrdF7mVyz351X3nLr/GqaL/zXhASf8DRF/A2/t+K/JSwd8UZpww6ffvPBlNji7HCttxAP/Um1EM4098YI183ulbhav8WZlcFJ73nmzd/81NTgblpcmxwgXsDWI/CMrktabVT27/1pQxCHEyG+FvVS1TsR2RoW6vR64fLTH1aNq0Pv95Zbsfy37oRESttJg2pnMYX0Y+nnz960A5YDSCzzn1JmsYr0JH4AhpEUnJxiukaGrd4LGzlk22A2ZdsvemzyfUR8mz/qgpKxRHhuAvDTZPsq/vGbDJMRFHFFKgJZlpQKWdnww9TAs2BhzsqNgSG8+DLLvHTYmxwlFy2tnkpZ9wpeiI71JWvPR+x2lDvDSpwAclcFDf0bdJEGuHVjdt1TLw8Tgv7lrZZH4MCn7YMLXJ3uTt2xIapyE5R3B6gAH3yHHMBmFpRlJlblkmztfYcYXGavQ8FMRTt46kGSDwvMZ7HiYygEbiQtuUD9PRQKCxGsi8p5kMh5qMhVjpGGcC7q9cbCE/6WE7SbNg1CrLDsc/GAt2XQWF5kHQ3HSWeNgOgerOxRzrgRLUEXHi7/t+cTfFuJ6Yx2BFFQDqZjFmy4SHRjkRU6+dwVW4OyiK/9p8n+lwBQfsDGwcPLoGtjtXqiIhcvvQp1P6ps/M0crLrlMrVZ0cgxMJz6G/ksPc4hUiFEXup9jpvWclhDdMF+HdvbW5NbOi2Ar7TQPdm4ngoD27wJBKtN4FfriVzOTuSyIM5q9lqjrlzAE/izkaOp3lg+mmoSBVB46JRrKgv4ITyOYPu99UjK9VdSz9RkiQnHfSTiQ0UsgA+zvYd+koMh+35y7Wbae7IfQfuBIXlrB3xzpVuZBa5JDvkTXL7HJCforU1675hhQOnntGIPGS8SAwX33mzTgD0Z8AJvvIDbeMgXDrbBsYDA4EtAjgPiklQywSUAh3iQGcURLo9sm1A2HA2vOFrgu5cTEbiW7YNG8oG73ZWdguZ3D96ifY9XpJmhEdZe59xkVIBh07zO7k2k4/D0nUx7hg0pV6A2mf3NAY030xMChstHUzw4NpnyFf3L0uN/7HtSrzIb7YUEnb1T0KD/8IkpRhRU8qrhL6iebTX395UsuJ16QQCqcCAv/KWtZ9NtdqIIr5IDoOfi0HyALw5MXryU80W4aG+pJfZgyUw52CcqHNghMee5Ij6aHoifrSS7+52MBpkdIK7MNBGtd9r3vvwNDyT2SN0ZHXjUqSvaR2YpRzpB/mSW3BN7gLDrqeiM98xtkTCkQZJxM0vPkqwhk4U72pJuscDfphx35l12Ds+jIzPKJBMnbCwgJu4r0LdXJm8RUdXWw8TvZHpwgcvnUNNxT78n0xOnOTW/m+XRKYEN2YogHeMNrX4unQa7GQ0fZup96lykSWtGEOu+LowMn9Gx7Ti9dPDHcGZ/lNL3P/RofMZwIBWtqZMiZrzAGWDgkIc05A3ZkLedEdqeRfPcb97TSGd+7Mz3RSZzVm3RgkATWtrsUfO9nvhlL8D1iJqt3XjlDZP5rykaMnfpELIEV1XJjD2ot9aZFWG0KbwsfTMvSGOviiVgU+y4kUZfqJDIPC9+4oFH2tkVZzYrC27LLSef2nBGBglsVGMTiV5r/Y9d4s+qksYiBMFx8s5GGY1CmRfHnym7mRZsvW5iWHFu9yZt5XzJS8CasyCWb/2/G5qGcd7zafAQUn9KiA2tLafeQpjR7ioe7mekCM1UCEqnK3a1XvyuROhy2VInBa8b9uONg6lIfpjUkRrUG0/VG+FiD3W7kuBIyPcncKQRpHBpVk0VvrI7sOKqprDe6emAHX3m11T+maO6roRXjzrtpvW5/L2ZdSx7elnw04xe2dyBIx7cy9G+N7O3fmjgKeGtqrm0z/xqz7+0d/Xiz1Um5nTAWY6DXZbKc3Bnk9NvfI+zJ6IqvMjpxE9928B28HXwHb//ePIEFI3n5OpJfDhwP8vp8m5x4EkAgp/fVMUBgAf8EDgqxGerwApigS6VgQmf7hd45zDPCFuZFNn0Y5uoWll0LAa1LWHgwavAn6C9grlVGC+vq49ywrpoeFlZytoDh6ZOM8KyDBZ5T7HjvKL7ZBgu8x6c3vkH5sJD4eXUWEtq7kIrbz7CP5fh1tHD64T0S98YPdgKjjoRbvYYV0UH2h9up8hoslFxrHnjAJaTOcAthhxW9OPGlshnCVc5QFkiyVGeo+gMYOwYgPc9yoFhllza2LGjM8jzT1zxrx1W/0fKca1hKb3luGNnl5TULcsuPaxBc+ckBWb5P1w3ONmrxfBGIn4clq2IdyNVZ3hr8OyQ9sKL24B1slj2VuMz/sFyu3nKtmgYAEyzXllYZLbeoFVXoe9evEwan3QeoydrpKrxd0SgJpzciDd6M0Nv/XOhV+eyagw0ABqnNrAht5mqGtfnqrS0vDITbuqr9sQZTIaGQNFQIl6H/d++btePgTLId2SZrSRiswRrPE/fWUqKHlBfpgNfDOM1oCVeBWuMMqjNubFkris+OT/1jrQ5F5hkb/kXmXNHRF1nMT5WC6UqF8ETVKYKqMBXK18yyDc7WsdyiNl2RNV5Qpjx3kadYmIo8dWtiK5kLF4LFawQw1Ys8n5bTo8gm+OhQ/MR2B7weRcLaFQmpPgqObReJsvb4HmEEcLWc5AKERDM54U/OCX8KXI4Vxlu9zbUKn+LbYw5EC0VGif4ih/beJY8ElJoi93UFTuo1kTR3M60Sh3JfEeeYXreCTcSISkSWf2iDaZh44SbVkfeF58orbbjsDllwFYpQfJkdtI7S2oEgYVNAHCeS8Kv2KJJe0K0yFGFEpvLvmjQwZJwtUo7JsYYFKmtI+ihS9qlIwRs4y94VKQXG67JrHItUDvo7EJSP6s9ZkRXOYJ0hXLWZVHWC42BF2vINQ1sufJP0hSsoAyAl6Yq86QiqHMrVO2SDYkaC/mTuyf8pcg1lEdZEIv9GJi6QpWmRqFRmRi4SI3ovknxSAa3JoyxyQNUzc+Gm/Ei8XUl14lmmLUuFb7boSGBxWMQUMprU0RUW7Al3LETXZpRpw24XjRrsva0vi6awjGXZdW1RJxA+JdWTKXUO5uGy+wsEUMIJjzJgPyyKDWUJHOARayi1KwtmNmXB6fGy5G+aZNSWJedzwLeqdS79UZYM8RGAv/ZBxs1VstUV2NeRwc98Ryhbgh92YvxQllzdJd6XhTTNfDid6KH35rUnQdL4wv9YFhMepQDZNqafL/et/xgCjO4fX8ggAz/p6l3SfOS2IAYcJOr/PfHQRcvmOidyko9nATKahAXt7ca89zb+SxBTXNd5EbHuD6whXV1Z2iz4MpFbPCuKKUkz+NTsDWutVsRK+YjPOjlhqPR+xacvuuS0DkUX0+XqiRX690mdwsUW6HMhAcitARKsYRddBc6srHPqkpk3eVMleFuvmAqNBDY+ysvsh6KhHGgH1CU4i8Y1H6PPQRjo+/HBL6OjkcF68GRT0Vh/kYDKE8ALiMvwFD3CkiPtHoFvYQxgiTE5QsnPSi3Ks/ZFk6C1BtDxtEItZJhO2UKVqWgqcgcnlYDgfNmvM7/QlwwnfoqeAdbOyhWBvvBtMEI7+oLJWxwJrEg+maM5C1oABz3jRlpIQ1Hj3La45PDa8RgJlPm0O5HJiI5lvM+RlKEojwAAvQ7oXTZq9X5Vazunom9NWEBzVlXT4Gy/ETF78/H3vuxoAbuCi1HxZ0UDugtv5yV6v0u20UD+wPRKO8864p5PDuRBbluvf7a49g6kcTcVY6430qmDqseZMxw7MIiDcmJ4IA7jc1N8b9dM4QuSEBWM7Magjh4OO73H2OUT+7Kr1P2B7fOu7KonvxXf+OQ3Dvgy33aNKKIAjzefoCl70Ij04UUbI5E1EcSbt51Pw5Sdt7L1RP5Na0DQQ1L+29BY7yF9Eyp4h7Hj+B/u3gG1CiNv9YZy92TJCcHKZ8SkAuRkQLK/JA9SJdKg0aD5aA2EAsbOGu1a1XSjuZFd0uoshnKbYVJm87N3ZMUVPvJWV0AW5YakzCjFe/7M4RdefRYp9PPDcSiIB/ezBYs0yWZkz59DEux/GKJPfj7hyYc3bjqLsrhTPKUJggzhQRDAmVAFd9N1ZWxAPElMP7jYo+wW9EWIkXCchKQOrwHwdmeyN7a7t+K03WYUJYzF8aW3/kjyUHaO04fvookjBwh7Q7Qwli57q3+wbVmCHIpkCbPyZpcS/GrKErySBTWTng/xyqMwo8PGTaASoqZF1y+xnqQCwiIDAePREF9TCd+bwAqvdCkhZnxoGhNovqElhrQBUygcfqduCT6WDf6gm/9H0OGpTo5sPwL3xQBuD0V7BHhXw0s73IBXkMCpSAkJDeufgqIeTFNfgreYoS75ALgtNnRjCdwhXycnHIsFPCK3Aqtq6oA7/wUto2PdJ5DrNvjr7RQRyfGrfbn7Kz7EPRL4/yX0/uWnwspLVz8tUF4atUq8CcLxuvuCl9ZlB3x0dbBHeWE+8grqX+O39OUVWGxegQeoHetIxMo/nmI8XAMZMhKZDq/Ai71B0iKo4q+QeHqmvDyFSZJHIqR0TYCKJXM4583FK9zNxSvYAQl5EOp7f/K6Vq7FlNcVb5uXz5VeEP8or8vn6VDnubsSZR5K5JXbN9pIZPKBePMBVroSJSRYOmA4rUiYKAjQfUsVrJy8hA4GKPFU7geeYBL9u99KBUc+jxKRwFH1xe/Jb+3xfSv+nHUqMSzK5SSy1cEGgDuLJyi4oGATAU0MtL0ZYUKgBJL8byVkRgyH17MYDv5NPj8EKXtGSEJ6XY8Sw4kPFZjAMd/NS8wbRmAxn0zB7PkTMy+qpvoJ+5joRawnsvyQQZevu5fYNnyOrsRmSIRW9j8lXl05BJ3bId6aA73f4U0jNpb7qhFXaGdXDo1xxJdLGcVbh4Pb7XQv2AvfqvzuQzn80reC+zYe5chbX478QnoBUDDJ7v7ucGlW4IV/cUXVP3KmUzwN2ZcDsXK4xnLwRkGbnLB8H22LruOpnzg2Sn6VW0kcfDxKkhNapvzGviT5/b26klRe+MKrpPqrI4HhSmGFcdyuUhIqTR2J9DbxEY+Scj5pN+dfGM6mUKScJ/ClkaoV3wYH0C1ieQMkyz+GlRqwRU6qrBaebnw1TDZT/jwlRurxdKaziBVrsHuOmLJfZwlySKIC5JlJyp7d8L0MANYCg/a5HiW1uSupMbpM574kT9z0TdxT5TXha1yYI/OGB+yQ5EpHwHTg1IWtMPDQRIWX0iyfqepJCqwsfg7aWcUoHczaQSVueMK0YjnhVBSoU2OcAxLtlnYjssLx5AQb+dNlMMt9hgJ2sSh06qe/n4YnhAXTo3MP6acvfA+4KyfXiE5c4Yk/MfKLWIUkaOJPn4Ocvk8K5LT/9HaXCszwMyQeXAO5YM3QcZzB+Oyok1COwVm5VRoNeas3OXFXwS5q8XKzckZOWs+IKK43ydQIGCqd3rKRcBuvnBHT6wtuNdtgJIfIBRQQXW8012BhBym9n4WdCLyIupw5beoA93IqWGo5c37Ccn6x1QK5qBr5Tfga/bV6O2+1OCBq/qTbROIdz5lr9YBVj0KuX5u10mdu0xMVzrfal9MkJAJNmnzgvwnCYgpjFqjhhQZjPy4T3Mxciw4FFj+7nW0L/zW0K6ddj3K2FR8bytnuAF7+aBDWwGaudkHUrMcXTSrkxNjY/dek7PyPCH6JTU4Yxf+aymskRNdR8XIOho6bmrqpDiSYvBJZ4nFbHgz4Q0w3ueAs48aNRocKawGzGxucXVOp942EX4bRUvX1u8qGtsoa0NlUeT47ythDjSKFQO7jOakKR0LyqgmGGN+bb67l+V4l6nEbj77PARZvN+9VuCpHSEI8UL2qHGfHS/b5fSGcHL3W7wJmFT9MDR0cSwNwgQKIfYhSv+1aFdvkFwlR/Q0o4IsGvHklqTR4l1Eyd8MHzyAX//MeVRCWjeqnBl0hMpZW4z+V5kbfnjj2vn3H+IynSvENy0TgCR+wxhT1JSNg8b+ehrXVbBdUsPYEZy6kssr6FMZaZQ1qN8HYAORyj1WQuYqhVVU9oefyQCOZJ6yq8Y7YUnXLRVATqsYo9ihVj75UvvFOCBFqJ9RMM9SMwwqiFzSyX3pgd86pJR8zgLUDTny6e2hYw/OpyZGBD8+njzNreDKcvgUHnRi9H6shIvPC0XgD+c2qDs7C6Mh0DL6mB+Sgu/rAzonJ1ZO4xstzHuM82OSpbCAdf5QarL8fV+BZbHzDgi+2KWpmtcoHUjlTbCN+menNsiHK2VcKasYSYs0ZZTrnjdbRzNac6cjpZzLAzjvTwFhJM+/7cTTX8FTK54eiMKX6g8IDycYQmPJOSbIPx0eVGwmVM/+a3/Tiw6flQDyhjPOmCYTjNyrd9Z/EJiceALTYXJl0Zje5Xai6YWXQGXwxFPgO+OEXOcKrwwkHQVHOjVe6TiS+oleNRdG8bJlP3qrpJ8E4ZXB7kkpYlfB0wEIKwPPcuJwJuP/nmcbySuUco8cypw0nj5yxvIKwO63Y8JsIPGxUKEwfYZbjMYS3MT6Nuc89ucqlZseoNyLt2jxng6ttWXIHQMnx6+iBHOAAqbkiJdvzyTxpz6dHwhetqz8x5egFr7GxbWy0Gq9ydERe3RuMIEi2dmaq2srhAAjcvO46eUUK47sKm6MLtR9huyLyqAkWZtsstrDha7PUfDhbwzusOoA1hBzIItfmZ8zwt81+GrG02d+AgWFeBlTaHFWwvwhy/39rhIEYDvrp90BCi/KSk5OCYQIFT9igt/mUpLEnofsYYlHdbnfus0Y3w0S0zd/1+DbzZmdHpomzX731LmIOcSQmjzo6ucWtXWhlYajqD/2toUbGixPTNtfM1Yo2u9QJyHXSQLMZ8MHmWps/OT89hX7V8lTasrTIITOEV0rz51ZLW1cl5Fd7lLaWsaDkGAo4CTszFCKMlIA/1KBUHs2aoK1qG5Nr2xTbTXfG3W963eQiWTybgqdXfLKlbvHpeR+fHJ+1+Gz2KC2+wKOmsbTI47UTSTN0li1WbJ61U23On+hsi02d7Wp5JONeAchflo1TjfMpErSAICm/xdk7MITelEL4KGB4B4LcOzG0hUHTAyeonMFLc4Hkvtx7MfcxjeErt+TopcKe9/ClmeeSbWq0w0tvqeApb4nr6c1vMgHGhkwySrgNFIUSxN1cnpAsb547RrEB+PJWuu6iOU4YIpfNubUw4sdBjaE0Ttoc0zo6Fti4R3v/mD3KR+apfL7XbpSPbBvC8RE+CrLCQBrL576NrnzEDqhdXfmoGL8VX6zzfBTTs49iT+7jeygfvwWbiEYWmDi6/IT76XEyZNfnu672CQdPqYDAFxjx/ufjfX5XPuFUfLGE8AnsLj6BjcaHq4dD+eScMFr65Hz2+GLk9smm/KaufBr8uhY5OAu6jlMqhtrX4efEx5tdA0jNx1CuJGeB2bRaRs5eSQ1Djivls2i5SShTuVLd9X+ziuHFZe3s71ai/k9uKgxVZilL7ip2fkbWORyWJikOdSLwXWewHNapylcUx9nWA6OOBCQRyPWosmQYqc8Woce7/wYnxdEt+fPdVdYzR52q6OuT05r/VNkGlJWq64N3blS5vYtLO8bKd30kParE2FVKb1Q5hI4e/hA+iEH1VHtwSlD91mGCXUSUvioJHetY5dQ7OH5jCxFFA4QC2lXOkBcZqhfMR5WzTFVMUm1R+orKXAGHLEP1x4q7KpapWMUIESnuy2+PKgUBK0URj1KRCPcti4Ozjeqw4q/T9yCMqO/48X9GVqLDrfJWS13F3tmj7lLx+Qx19zuciIb3Wuqu2tVdnxXfQ8e6+yNHJIb+p+5qh0THXGAZo/tWYKNo70UN5ssZJA1192NLjnUicgJX9/tdTidIlD1sO0zygQ+e7Of31eMbL2hxQOHobjC/4Kz5hZQ3Qx6SIezBqmrqSa6u7pkOZ6ZJLohmbkXS2tfdIClYd1NZR0LVNIHw6AF0wrMSYiS8Yf0e4tXdsiCOt4QbFRhDy8gf6nAk7IybtmSMlL/1+ah7W7uK98hGfE9Po2ZllQuaSPTPPWOsOy8A7WoQk66GOeC7SuxrWOdQxxrurVqSrTxqWK+uhk1tqP4QuiOSDgjDziyfOpJ9ZIUhY+EMUfvKVSDwiE/tajjC2uOLQAUs1cK9tKJP/RlqSM8cEChuA9X7sRv/fwGwMQD4KheN+O8vBteQKtzAVAd/YOPMzyfg1NUB8fH5Sg1nzeoOnTWf/S2JW3nYoXN52+rH1/jvNdb7iX4q12xQXmmI4y/iVx+DGOCm6wWLId5GQuQdBJXyu/jWbH31g/sAHMQjKU9UO5JshzOe4CA7g31ZtvplhW6ca84Gt08s6jjmsFLl1C8ajZ539M7SVS/WfK53JJi799ZSrwdmSFO97wlAnPQbbZX1CDEO1c94dcDaV9M56qOaIon09ULiG8XIJ0cmN884ALDGUCktXE0LRiB9tYABX+UJLnw5masWZi4kQmluCQ0NiWtR7s5VKnwOi55l9FkZwx349sJNUBJB3N3FL0cgcxM5ZiO5lkjXVk0VIUKJsvB0exiiRnriq0wgeUNyBRdEgQpHDURWVvN9H+jwTqRqIcPJMyMFvJRCsx3nPnB+yIjmdVXEPPvCVrV8zDlpj5q4I8z59D6TBM7n5kme2zfJc9t2mGxFDfmOBcqKBiZ2mEQqv6+h8q1g/jvOvmLbukzVmq/kDWSKEuIvPTjxItEKO2hYrfufaleHeeU11jbfXWWbWxQb6r0LXpui6fpTG7hfsFNbDGfvs8jx3ySy8lHg2mxGz9FsDgm6NuenJCd/tU4gPgmpzZ4w/9IL5g+2Mc0MHW6zijLayqvjctejttr+1PbT1Q+2eOpHdeW3jvj+qpW+fjS+daofTdwn7skuGLOzq5+AluMTuHbhyHbwE7axfoI/g1I/ISm/BV+LMPlNWDBdb7SRyJwDuQDYRAKwtF48T0uB/ke9XtpRoGBssQauh96kHV2L1WRqx8wd/zi2YzaNUXr0IKZTSxje7br2jeutUFizJV3JMFtZx5bkaRLWoSUJ5lrRT26A+YnllsSOfDPK6ZC1NSBmLfGop6MBZ3nRxH30c2xpZrfag6TVoUI5iAdiRuwTrES/PIOsFDo/4wQXDOVYXQuTE9fKP66COLhLFtZIL407FS3NfI2Fei3deg3JQFJrTkNLi6R0jUC0qiRc12xpUePF61g3lQAHl50ngZ2ZJwFvHAOGd4h9S0sUGoh8IMTZcbrRiMfXgUqD4aRilhf/Znoac909HFnqTUJ0l3LMdisd85ccOZFgqxXuZHvRrFk7K5nfG092RmTTwovggW81oOVSHD+MJyS/PcjNM3/xl11GsqgrYtHg+ioHescBzPNoFfrXI6eYFKsuwXN2vU+IgplsehM7qMdrTL8sv1xzadXJyuQhSbfF5+0oLyG9Wc51INuyq2x2B4KXjzqLIek/djt7/FPjnbRkp4T1y0oZb3a5Es9XkvHgkLOc/rFvDGr+OGnl1sPs8svS7dXHK+6q5a6Ma4jtTtDwvov4mildAYKGBOj+mTy9NPqFrkDKlcBOK7cWrwhqfqc6FFRwEBtYlOoFS3QkiKLeBz3JFGVMWe2h5TKdUPLr6UA22W6ld3D3sd8FLUwEBHVD36yX+nPekdQfjHbAnj626slsBJSqqHxP+XUT6m/ft/QMHrXnN47PUD1gTxcWIvu5Q/8MP24oyscN5aUVFlBKSALYqsAMr6Ek4VYaCO+GolL+ZTI8v6a3KL834Q0nIA1ebnmNaFNQ9ujlZkhksiZ22253rbifIh+cwd4u5m3HrhLrTi0Vu1XM8/G+Qqml+3VnKPEEEkyHtCH0O7oYhmzPzNs9ZzRWgTLXLd0Dopb89LgjymbwQ9ZfFq+u8UmklvjIq+ukv3frEBJ35pz9U3ujtHQ8kgavICBLB4r+NBaHUFwHQ/X10VJAXQh+xmy8GUqmP8U4tPTS46x94yNDU0uvlO98fyWXInSGFIiyMEJ8xG4EfjyNot55Ev9VhKgYMjffgAS81Rvcr8AP2OvuSfnmmVu727L7KTOQ4jGOjMn95hg0sniekxjJ4j1l9DNNZO+7xLI0jY4sR9Gflm3pfnFscIYMPWRFqPxZKFjyt5VI7g4NJ51vUtxTEHfQXwei2n2sGexbdY676lA6B1Hm6yow8i+sxx3W4xvEJMetle7hwv0oxNhSUnV3ktpbJ8fbWuYLgmNL+VQfhPil6ii8OFA8tuS39/I/2+XTV4iowwOZIB6cU9WbhjPcCX2GmG/DIbkHfoXil9WbfWuqXyVICzF/3N/YENbvJXct+Z1GQ0u8MWVs6b4UBMTd4zzMGedccIYXNhEi1fXuhe4LFMji3fnfp7BB2CvdZ0/JvgM5C7wpBeREqvIcn5vJcrf+lmc3kllPfU5AhcYi5j3+2JKf1OpBng7BlaM7VO4U5pka1OeySPUiVBZNt6HFFL1eUfFsKN/Gyg8euJJ6Q1ZUDzZORdm++qkAmo3YkKdOcoVaPWIUVmfdgmS5W9u/IdvF+xkX91Yyrh6A3QJ7ZJ7YtwwmlOJdBl0ODR4nOfE/L4EluHAsBo0cadAPLYPYHUGXoxnJ7pDVu+2gnISb4sIkTXG7nUq+GQ4W7E4jbhdTyTdswbBNSPznFnfRYBx7PvoljGQLXnzQLMP2xxv+Sgm5CSTceYZRTCIet07aPCJVbr+reHuANcWb3GWZS00A5TfCcPCuFMiwcF+KLnuphDXvgHk82rVM7vp67xGTsfDASV7eQTwOd8DY4XKCCi/a3aRzjgW8Bz1+ELoHoX4rjfnJ+8pXktttXN7vdt56j7tBvkp3g/MOd5byLDOdxFFlOPn5NiUfMT5xQnZRp+y3DlvdoaWPyhtJ5iOTj8YlH7ShkW4FjSwcHz+5BZ2QaPFuBT7/Rjx8npc97CdbXFmjkNuugNiNZD6e+FDmEOh15eOrSmQux9nSbzj7ds4qdWjnAkn3sZ3+fMcEgnS/+nauUnVo59NygkmOesZ27ipv9PPnrnEFhMh/rDk8vQD/zvut0r6d6B0f7cyp41ba0E5/0gHIK3FBcKK2nZbFAcP9k2f1+nbyYHI7KYg0EoMVV7DqWF3DVA4n+UUrHw9C5Xz25FIo/uZPGnkwNqG4nTxj3k7PA5ME4ScuYfTYywtpbKZ1l6RDs03TcvXE2vvm3OSvBLFo3u8F3Sf0G0+ytkLx5EdjD1TUH+fGX+vQPuPbJA6taiqoZJVSM46/2vt7Gn2r+QzSURpteMsiCAixEqqOhJDT+JZN5pxWEIPByQkGXG/ZOGYjcpXrLX4zC3Dt8W0HICpMRd77VqDbdHhLCjFKDyw7oV7jm2ujZYc2sml6y8ntBhtRBeHAlyDMKLYDoV7E3BAKlzF9+w4LsATXZ9V9i7/0BaJ8TvEtfMwbwG2Xt0rs3rpJ6t+6bUGnt+6Blvq3xsXy+NaYFxxveGt8ax35dHGtMrx9ztO/KWo5vTW5JNTIA5kYZL415SO3Ar1qYYkKSzxdzasW+IWuzbz8e3SGFFebc4FxW8NS+7caHkLjQHj43rAAdOtVZxNa9x02ki0/3moIdykaH1j2HXhUX9LjrRUx+/F34t//Xhx++6ncd5i1PN5h0fEdFi5oDu+w8MJAzEHziEvA2JKCoHQFpZQPkMNnEN4jNr7Dxst+hzeKwaaOIU3vkNbgpTAkvcTW4R1YGIZ38MvHHVk+ybL1IFoJIXXvcKp172CIdTBKzBIh2PMO1hCNItM7lCV74QBrZcSgia8OouWe4xeRBsU9LIH7LSQZnhT/Vhnf4X4p0MkvbPPFQCocISEB3rJ4MfXnhCci9yTf4UfTyI4ilHoTOJL9gVwg10zfOSxQRiZsj3cO6/hmiiDlM6rb8M7RkyzHdqDS5arWv7OHnR2qdW8MXPmN/TtfqG+f7x7un4+s3Ueeav2Hd+91H9l05df4Lf2HElv4k9MDa339x0Xq8f7i8JF4iNX+IzE1AjYHPxLr7+MjaR0+kpLM2nuXiL9s6T9SZA4jukP2oiCzlJCc8ckbZ59IJS6KDWCrXL13ow5Gfb9o/HOLSQNzq3SC8moglA2lVm3z4yPlxB/ur7I3xldt/PAKvoKYvz2e72v87PduN8n1+OxydJ9d7Nl9+EjNZ1e+59Z99vB8Tp89HIVLHp89pHRBOcGZwGnX8NnzbTpHHT97PvAXyvlUKvhFFSTlz2e/Hh+cTPmEVePVARL+bFrxJ3/wrfvE0QInX2Rqj094Kj5h+oRX4LMF4yfElRfLO6k9EFEPcX0GUyf5E0mipNVJeCqNxov/PZU4cp4+fmgpXsN3nALMH2rwouvHJ8T6+ITj7D684RVfJnBI64Nz988tQPnxjd+Pb/fCj1TpwKkdRz0jvtG9N3fDrv4TypqPxycUxLjs4yeUExW9A0HIuL3Rf/iqd//JPClOoK8g159Pfj0+OT6nDy+YCUknjqv2nFdnXHsgY3vnDO2Js+vxycf8gCxV/+ELqOCx++Ahsscn2/qgFOc9JLvRJmLJh3ZgF/4X5X432M7mDqx2HLl1HLf9+eTP8HHpyO5jUvf+Yyp1Hz+mvI1rIEFyAhlR7tENH6NM+I3X8HG59xuv7sMLYDEWZN74mHD6WE7bmlFYjRccj0QcWPvcEo1EDhY+3KGqj585/3Q/avmnu2TP+c8lx3Dd12ZetygFugF80uNS2cdLhS9ekTCDSC7+p4DhpVLqcPmxj8el8fxzaZr8lvtVruEK6wpRgwt14c8VUn9hEqjdlf/nlbsrrxofV96kv/LWrPZXTqgzV4Yutqf+/Mo5/KpEdCq/6Gv/YAXwV89TY0gPisn96v1AevfLB8B/w/brx49+w/kMUYHcnwVej99wlj+/ofa/eQ2y9L/5mIM+fnPS/peSvdNvzi/VUw3sHi86ux6/OR///wEAVlNd2tXyAAA="
+
+// Encoded and compressed EFF short word list 2 (unique three character prefixes) for passphrases - eff_short_wordlist_2_0.txt from https://www.eff.org/dice
+// Licensed CC BY 3.0 US by the Electronic Frontier Foundation
+var effShort2Stored = "H4sIAAAAAAAC/zR63ZLtro/df+bXNtjJRR4h16nKQ8kgY7VB4gjYu903mSefSYl9purU2W5/YEAfa2nJ/+P//fu//ft//ce//u1//utf//l//vd//ce//nP5Xx5A4wv03uAAjsIYdzgOxRdBRwdHlIK8w3GJKnKwUxSJk4eDRQtkD4dCI+ENjiZ6fK4NjsB9geNpzUGAiOXZIQQZ3IG7g4BdGD2Eixhb+4JAcYMgo3UK9sifQYoOgsoBdn9XbG2DMDraAw5QpUneAFu/sFPY4Dwpwov6CmcWsB8FijucHbVd8MIFEhCvkJDDs0FKNqboCumC1h0k6tDRQ2Jpc8QkTL9zRUkRiZMDinlOhIr95ucLvkF3yKAlZAn3BvmArmJTzOHC8jjIp/1bICfAFTIhNw/5hkyMDnIBhrBBFk6NInrI9YIDu4OsCPH5gtzEQ+7Ux7w8CvEoK+Q3PG2DAjbH/GxQjpGBA25QkGNB2+lCcGTcoZTB1EnYQWFs/VmhCB6wQamZTkJdoYyG6oAhKZQVOFxif0YV20eeW90INuCE+aR2rcBUIK/Ad8a+AbMMDqgbsPBTZLQVuL1RPXDHLBUd8A9hfzzwUzMEXEC0wwYVtNuMF6iMsEOV1lXqhQvUmtFBVQrSd/gzoICSeY9CuNgcRw/qOm2nEbnnZwFFBg+ahg26g1LrEhS6N0tdQOpBpcC0s6q8L4ToQZswtb6BdgqX3OihHdi6tBVaQI4OGtZOwUG7oKD9TUyMK7SMWB00CQR5g1ZBIY22ga2DYfQd2lMKdqWwQM/QduhFWr1Q8Qu6lM0cJ5CMtkDvFDz0p1KAvMMIZjpE3WFE6qI0ygYj2eIsZkerNJ/cYXQpclDGDcYPZQJ9NniBOcaFK7yQBzqwADfjviRAlA3etmHY2grvi2y73/cbNK7wZuK0wFtudPBDkPOzwi9kBH/AcWQLjwPCXSHc6wERWnMHpEoV1wNu1Gc75suJ03pAOUTWAxgYtgNUIYwI6wHtxu4P6JfKge6AX5EbliNDRHdk5Ii6HJlKXY8so9nJoYrRHwL9sZkecgToX4fEZzskjWbL8YdcWAh4PYQy6noIM/blEOnNHaIy0rUd0trMQ+shvWd0h4w/A7s75CfTjeuhCP3aDiU8AzRcDhUp7tDRLmzbMY4jYxplPUa4M/pjxHhR6+4Y5wlZ/DFyPlXScgzmZz1GO+Rxx/j9BY1LgIN4DRD6aD5ApJwhbAFO7KgEXwESugCXTdoH+Ja56wFufEO+9wA5jGz2XAMUVPABzItRXYDaRsY1mI/3NUC78L0F6BdGhbwFGAGa7U8wl9AtIDQ8SXEJGEHXgBn1WQOap60BudkUUaFQsIPWqkjewwX5PgQ07uFCbGiTc+Giwvj4cGVRYtzDJdVS693WcKkUXMI1OvpAGbir+EDMUITXQBoyboH60wJUu+VlzsxfIcOzhYyangK8hUx1vnj55N+Qx3GZh+xBoF/ACXUNcrzxcEGC8Og+SMS32FwFf2yjuO9BzhPntLcgianTy4a44JiosAfJGfQQRh+k1NFRfRA+sXdag1Sar1FG2IK0goZja5DehZcgI1xbkBdqNi8L8oitWn6n1/mg8LZ0ugfFd8FyoH4FpWMLKtSaIWbQUY6MLujTOmQXxmGZwYcRht2/hhFjfnwY55mJbxcGNcOXMHJV6v+EUZcwVB8XRrsMB8LoFDLu4TlQ2SbswhMyte7Dk8lCbg1POewtD1OgVlx46gTi8HSpGVrZIoSrXYOjj3CeEimvEVJCXSKQPls0gOsEvEXgiNmoQgTt02ZbhNZBbeIROh4it48w0tVRvyK82UV4OhV0EX5/LX4jwufnUGpLRNuDiJECdPQRsU52EPFUaX2NmBRxj3g9US1ANpvBCxXVRywTEFxE7pbzI0oUNd4SsUr3EdW8J7qI7e5St4gdNVkcRHxRwC3iT0el/rhI5rXxK9LR9kgzW4M+PtJ5jmabQYm6i5ShY1wjlZpxj8SMaqnXRZIfivZbsxTYIimGLraB1K6QpV9LpN7FR3pRRG1bpN+P/6xR7NYlSkz4FSVnH6UQC7YlToePIto61jWKNshrlGGkIMqbWwdSu+8X4xYViC11u2hmRt2i0tnfInZNaiVO7SvqKEvUxy6Pw1DH7GeZz3J8HOeJeY0jib15lNq6WWzUjD8uDjU+4uJovQK7ODqdIy9xvLD7+AY9qRUf35httC2+iaMd+fgwFDIrPy3jD8GGkFCnvRE0y4ELQsO8Ibyw2XSX6VEbhoBssOsxXJLnA5Y2Gm4YpD2tY3EYWof2LBgTxhUnyHqMI0ycRMxv0bIgKqHD88TQ24opsaQNkxRgguDxG6fpHWawPLdgPuTtMEfU/HjMWC/g7j/sKT87ZirE9op/MN+7LdrYBeQNjQ+as2yYG74vVHSYR6MXLphf2HYshqrVkhOWQ8W84lmwBESHBRVy9FioNRL2WKRTsJmVimorKgYZuCFDmMRrQw4XZYiwIUfRehGvyJZnPfIpGlBX5GRJBfmaPBOZ0odDIX/LA4ctkjNowuiRRY0CeuTJ5nVHVsnZXuaRG1pC82aZ5w3Phjw4EHRckF/yrMi/T0GPlSIWe8OfQfXzrEIzJ9pQk7AUCg5VbRoedVQzwIYtwAcSsd1UZMMm9TJC5rHNHCYLtgaPx9ZVErLDbtVIcthRGfKK/aLQNuxkXMB2eQTIT+2jrTiypGfDUY1etLLhMKyhX9zxBWHAZxIv1KSI7NFidu7YS/Kwi1/4A8XhT0CtfcMf1EAWcPhzSrZtWPHngozLRCiPP2I3dIc/NUvEDX/+WIbv6D9JSOzRcaAC9/WEQym4E2YKWU6IGP0JlBucuJ6Qg/B6QqH8uBP4BLWzraOdHQG7P+ElaqOfCI3MriceOkAfd2I05/InYjTm506kxBjXEzMxLieWoctpy11PVLXRsHV6Qd4NLkcIxLidOCJMtDltnwz+TjpQU4bW9tNyaLfcsp4UY0Z/UhpKjOtJOduYxH8fJ7O1rYR++lDczwztypSuvp8Z26fEXM95xp1ZdD6VnwoV1Z8Cs5ZbTgnD/k/p8afkuwmn9RSOA/0p0iv0az2lNcr+nJUs8T+n/PhTYRJxdyriG571VIoJ/cQgY8+nDurbqQ9xqsBrgpiwbwmIz5HzsyXIuXVh3BMUvBErqkvAyUZLoJmCT9DmCrYE/UK1lJhgcM/YXUKjJj9LwnDLPwnLlpBxIt6eUJKCVRlrQj0ou4TN9skl7DBfgE9D3dMlI8+0tCU6jmmSLVGMH5KS6OztkuoTJTieji5RKRRul0jhPNEneqENuCT6LeKmIbG5lBHPkV3Kk2otKcsLXcojSEOf8hNsNWti0Py4ZAHdLp8EeruJXZKUMjafJEfLml9JOC1JBB+fRBPKaC5Jm+CTxILWJRlasPtklItFF9sB3JLic8nguCSlnLek9sclaUuTXhngpAEBimTc0kC122BNgzqoTyNnMvBKoxi8+DQ02UNLGq2LS6NPcElPYWh9Tw9jEEsUPj06U4L7yyfdBeG2ZHNBSkZeL6B7uAuyJOTtslJeE6q7gGMadsbA10a/QOM1R2id8rNd0MOVP2MZc3r8Bb+YDfatpjVdx18YE16SlgvP/mwXEk/UXi/MBft+YZGU5SDeL+SAp2i/lgv1aPuFjfrfyeMPJOH1GkeA6i+jnMTp6xrn6a+RZgW5XyNnOCCLLNcoYLdzR92uoUoBGN1lDJSTvx4IxP1ar+dQim6yNO7b9STCWYdfT2WZ7IyOUVVOZEcBrdhcaJaUBmoUNposLkBeKZKE54tifhZKWcRT+igfK6UBDDvlKaFAR0cFks2EyoGBMnoqtljRzXw72WwclarEfSWxSNhJ+L+LdqoQV6qXBS4pRWzB0gDp3aTgF6nwTqqU4PP6loGjoyZdKm7U2sCpJlGHTIF+0VPHQr+oG3ViVNBnpT4Y20IvI4PfMAOT0/4N4VY4DurrN6QBun0D5Vn5+G/IUJHFf1u9rYjuG5iMx3yD2tPuG1ohtjsHRwq4f8P7UIQbbZzHykrUr2/4/XXfCFZxf30j1uUbc372b5QKGukX12/Uhs/6jRas7ht/8cC8fNN5Pus3pQZv/008o2T7luOSHO0VEu7WFar7lmQG+PqWi/23EBuw+2+x4MiP+5YxsfhbXgTZf8szy0f/PY5ZMm7fI1Ig26fvYXli+R4U0H2Pb+ptuO9x4yE/2/con/Dx34Nv0wuW76Gi+/donU5LzP57vJAp43LDIYe/odANv+hv4AQqst6g0O3yA7e3JN3gxvVGZsz+RpWGjO42HjHqcl9w03ZbsdstBm/KMnU1oyMszlYYpSw3SbvdTa3ZPtzU0d0ZkfHH3wy1GcLejGgRdzOFG7Utt0AGd+tTu/Ce4ZCZ7J81Q7QNznDjqcLd2S6h8pqhdql7Bn04UafmMjRIDEu2BOKyeYI+PsNrqi0+ww9YEbxl+H0OYWw+Y+hD7Rqe3VKrzzjN11xGakNxyWjVe0ZO/XL54yd7xqoYLhjs8iQA6DOOGwvBmg38beh3NPtsmeCgbDVVpsMCwGcKomTP0ImHQN8mDh4jHz7TjW9quGQyySSTEU5i/MpkayeZNjeub26zZvozKHoDolmIZ+oddZ8l4Xto62umqcjkDAW+8oCx5XHMtNV9HoEi9WfL5nRquJNHSpDQ52FajBaXZ/J79jyrcgtSnweHy5wwD6X4mdBoU63b8vgZakdrfpRC8wUCqDC5ApOY+gLJ9F30BS5JwM9agApGX+BbrPb0BW40Y2wF8ilaMK4FSoG8FOBkI1S7RV0BPQxJC7QGCXUvZvlPQBUwDhZuV+CHyih7gUeYgRq6IsAWIFNWtCxVJARoxGuRSOfji9BkFN5wM4xsBwW5j7IVYWjdVMAiwpOL+SLajUn6Iu3PoC57kS5q4gNuZkC01LAUeREuRd42UzGlDHOGrYxwf5CwjHhmebsyUrukf5WRcSmjlMeV2YHAtYxasS9lKGRXxhQbfBkfPFvLowTRladVCPhVnn59MVD2DAUtuHcGloZBOK4M9Sb2DPpREmxdn3nwjJO2M4wJQfnxDC/L+ugYQbH1ldG0+ZUxdNCN8YRp9p0xSf9osYzUL7Tfgo3skmQ6UCGvjNV0O0Z9Tdz+YmzdMfZuEMg4VHhji6Qiip7xp0cR9UxBbJILEwbcmazkmh7IxB05imPSFzA4HiEj6MojJeyeBzXggI6N9JyP41Fmc4etwILcHA9tqM/OowcFS0sLP1l4EWjU/hHouxwYaerxXg6aNl/lsBp5lyNTR4WOmxwsP7YZXo6G+kJd5ehA7OR4zU2SEEb9UBAJCEzBSehSR1vFlE9d5TwNxeTsyJ0KNi+UPwlfiD+NhQk9XvJTqtV1Mktjk0GksKHrnAEHKcbohEnYC2cRCx3h1iHhKmyq+Jfw87OIlHp5qfBnYH4WqaiwSKVRnFRpbRQvtQojdyd/q3qpI88N2aUF45ZmeGlFGjUnrSuFaxNzg5nVTJ3uXkafuO7kNTfhS17Iq7yNrK/yc4pGZ+qR4bH8PMkuPq2jLvIrjJs59ycDVIgmkroKCY3aVPiGAu2rQi6+QqlXxr5V4P5c0tBVqEo3+AoKN2L3FVqron2Z5lgrjIrqK7ymPuwrPJMNrRVNEd+rZQMyXr9VjFKw292YpvjnKmZLrK4ip0G8VBS2G2tFFSZvPyaKbRUtSX2OukrGUdaK7znYBVogPL5eCM0YwZzAVBLrpWCgutXraRQIeKkZ+F5rxpjQ1Sy9Y/yqeSRX8zNlrso4ijDBXiUSdKXW1yrYKXxVSbJWoSa81klRtiqZbHfthPXLXJUaRHmromFUYvRVWg9zvTJy12erltIMyFxVICV0VbH/YvZViYOhYFWpmDPqUnUw/lP1WWvDEWWv7TEZ3foRdRzmA6hrHRaAax14ZFnryBkfV0exlLXVDwC981JHrc9SLXLXOto16lcdva91mLrq6qNQKK716Zew/zNAO2rb/wxsn67E8mdQ7sufIR1XhSDCq4JJlE4hvRGjV6CsAnFTKOZJ+dkU+IOcq4JB1qbQ6oGqdvSiWQxsiqBTcHWKh73FKQakar9TJPKKJzHOgzTbG5v+FXZxUyQrmZodfcssDbziTRwzLmqOtioWmXeyxZHoqigV2SuaR6M6RVtoXxR18KZoyUjI3tKVLLk6xTHzgpqM8znxniqJXlCbxMfrhV2sw6cXsTi9xgF6LHo9BVel47ANo3BhW5ViRN6U0ocVrErFOopKtVNEp9Ruys+i1H+fTen1cZpVBQrql8qBq0q4sXuVAhxQ/XQbunFX6dQaKtnh4NiNVy8qD+RVhxURu44YPw2JRUc6nlUHsRlw5Bk/TkcpYPYYzFY06Kh9KG46Wq8qcvoGh3RIuDUISpaCtwYxZjwguQanUdCtgdV4InFvkHu7ZiZqYJhMvgFHU1Z9g1ovUnQNNBpkNWjtcc0oCualwWBYG7w+b/uRGeZLC6CnbwEZlGRv4RLJU/RtgT66vmtBxJylBYX699rIcylrC0+/cGsYFLtRkoZxovneMCmmqaA0pFY+dXzDjKFj3BoWstqeXcOp364Nax/FN3MiA86GWpH72rBBsT97z6i+mSMZXjSTAJZ2YT6XdpH2tV3ywry2S6lU165hT7h2PZNlNIKCDZ1hlqF+I2wdXCPjx7w1c55Z21nKy9j2NmPENn5t1IMU18jExega/c6PAto9my7GidqNGbvw0m7CtrR75Nu3+5lU27c85Yf01bKt3d5vjGtp1pfY24fsWlXaCmj/FMStTAl/Tr1Q78h7K3LbpMO9tjJiQt8Yqo3kGyPaZwxLYzpP31jebZCdH2nCeKuzOm1rM2H6Z22VrNBp1cS+uR9VOOHaqsroX60O9a0+U4TyRjNVbW879IFb62iVMXQ/BTqFZ2td6tucbW9d4T2zk2t9xGnD/uTRljbghWsbxxse1z49+a82YtvaOE8J0HFpI4F6m3sAs9bI9Rq6NwPK1sV8buiJujQTQb7aG3hvb4Q+fcC3N5U3gi7NGpS+PQEmpWuP1RXZDort77O1hyFJGnbK9h7b9mllmAg9w71VEf7qEMR1iFUybh3wfgtH8R3S/OTEdbhRRvcdcp5pqEOBjGsHtmzQoWIz1OqgwH1k8B1ax2PEtUPvImu32oG/+gVv1y8Ey6X9mlxz6Zcor/1Sgb70a5Rj69cbdOqinUBh7RQP6nsn/KtALJ3S/N+wqBM3zFun2gXNzp0UIq59yieu69S4v7oirl2pSvRdZTRzla7DWs1dn7nAcUy5xfWBLcLj+jAi0pc+MtW9zwarQdjerWqYfbm1D+0Zv/pot++jixLktY8ftC18I/6itqW/idveHwXm+b2G4Tw0y7t+lGPWLaspEIrbYJgkPe6Dj9G7MMZlsJU+gyOqGX8fjC/kqaYNPrNp8Rj94CmdRjfYPB79YJpFkx9sOkZ+3OCb5c3bYON5BoKDC+iNcRvM0inMcaQiz3GMudlMZs66MK5jtmj2wc160bbfgzvNe0cbkP3gF1KeD72V+P4cPoQ5mkkH/1JdRz0Q+jbq/OJLn3XUCB3dqB9RYtRkRtxHvSTP8nAd9Ua0J7NA9NMAYdidOjPQqJ9G5Tpqlzevo1pj0A0FplGWoQfwOtQ6OH4o9gsY1zG7tdtQS97Uuje3YCi4jGaV1ujIjbIbfSoO6+hFWl9Hl0qwjd5RgQOu1tEZxb8g2d/P9oI8ZoDtL7DeVrswbi+oovSLur5ACaN/QZsNke2FCWdAuhdeFDKuL8xBZX3NRtv2Qu14KNidrdMx5p3z5f6FP1MJ8y8KxMadrJMkCQruL8L3Ofv024vS1MPQvYymJftlTKDriyQTby+qqCafLy/S0ZYXNVH/og6FuG0vesHny6MX/SB7+ywoU+vLyzLM9pJJcinvLzFqeUDO7mVfNdhy5YGE+2tkxtncXd9wnhmX9xSL33DjqOsbso7m32DzRf16Q6vLGzrq+oaXuY59gtXd+6JWUff37NRCtlsp3Muborzdm2Yv1b/p/Einb7qpYiRwb8oxQPfmF4Vydm+qKKMvb7LgeVO75tcb76nw6LO/ZX6+NEeZbM50o7eUY3+LWLv3tmmI3p+vk5a3xbN7Kxi8+rdiuG3db6XWP8jxVuEUxRbzY7XL9vPkv0TlgXD15YFL5MvkR//Mlo3c24Oto1o+eigak15mMC2P/N9Hlkci5vWRNLSvz6iVcP1FyNKXX/MZ/4u1mpttv9j6p5v0S+kXUsLofilnq5p/acL39ksaxAJm/ZVIENZfKQfhZl9bIVZU/zuCxRD9/wEArXZwLS8qAAA="
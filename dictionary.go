@@ -0,0 +1,73 @@
+package passgen
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/fs"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Magic numbers at the start of compressed dictionaries
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// Create a new Passphrase Generator from a dictionary read from r, with one word per line.
+// Gzip and zstd compressed dictionaries are decompressed automatically
+func NewPassphraseGeneratorFromReader(r io.Reader, min, max int) (*PassphraseGenerator, error) {
+	return NewPassphrase(WithDictionaryReader(r), WithWordLength(min, max))
+}
+
+// Create a new Passphrase Generator from a dictionary file in a filesystem, such as an embed.FS, with one word per line.
+// Gzip and zstd compressed dictionaries are decompressed automatically
+func NewPassphraseGeneratorFromFS(fsys fs.FS, path string, min, max int) (*PassphraseGenerator, error) {
+	return NewPassphrase(WithDictionaryFS(fsys, path), WithWordLength(min, max))
+}
+
+// Create a new Passphrase Generator from a list of words
+func NewPassphraseGeneratorFromWords(words []string, min, max int) (*PassphraseGenerator, error) {
+	return NewPassphrase(WithDictionaryWords(words), WithWordLength(min, max))
+}
+
+// Read a dictionary with one word per line, decompressing it if needed
+func (p *PassphraseGenerator) readDict(r io.Reader, name string) error {
+	r, err := decompress(r)
+	if err != nil {
+		return &DictionaryError{Path: name, Err: err}
+	}
+	if c, ok := r.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	scanner := bufio.NewScanner(r)
+	n := 0
+	for scanner.Scan() {
+		n++
+		p.words = append(p.words, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return &DictionaryError{Path: name, Line: n + 1, Err: err}
+	}
+	return nil
+}
+
+// Wrap r to decompress it if it starts with a gzip or zstd header
+func decompress(r io.Reader) (io.Reader, error) {
+	b := bufio.NewReader(r)
+	magic, _ := b.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return gzip.NewReader(b)
+	case bytes.HasPrefix(magic, zstdMagic):
+		z, err := zstd.NewReader(b)
+		if err != nil {
+			return nil, err
+		}
+		return z.IOReadCloser(), nil
+	}
+	return b, nil
+}
@@ -0,0 +1,104 @@
+package passgen
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var testWords = "apple\nbanana\ncherry\nfig\nkiwi\n"
+
+func gzipped(t *testing.T, s string) []byte {
+	var b bytes.Buffer
+	z := gzip.NewWriter(&b)
+	if _, err := z.Write([]byte(s)); err != nil {
+		t.Fatal("Error compressing dictionary", err)
+	}
+	z.Close()
+	return b.Bytes()
+}
+
+func zstded(t *testing.T, s string) []byte {
+	z, err := zstd.NewWriter(nil)
+	if err != nil {
+		t.Fatal("Error compressing dictionary", err)
+	}
+	defer z.Close()
+	return z.EncodeAll([]byte(s), nil)
+}
+
+func checkDict(t *testing.T, gen *PassphraseGenerator, expected ...string) {
+	if strings.Join(gen.dict, " ") != strings.Join(expected, " ") {
+		t.Errorf("Incorrect dictionary. Expected: %v\t Actual: %v", expected, gen.dict)
+	}
+}
+
+func TestPassphraseGeneratorFromReader(t *testing.T) {
+	inputs := map[string][]byte{
+		"plain": []byte(testWords),
+		"gzip":  gzipped(t, testWords),
+		"zstd":  zstded(t, testWords),
+	}
+	for name, input := range inputs {
+		gen, err := NewPassphraseGeneratorFromReader(bytes.NewReader(input), 4, 6)
+		if err != nil {
+			t.Fatalf("Error generating passphrase generator from %s dictionary: %v", name, err)
+		}
+		checkDict(t, gen, "apple", "banana", "cherry", "kiwi")
+	}
+}
+
+func TestPassphraseGeneratorFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"words.txt":    {Data: []byte(testWords)},
+		"words.txt.gz": {Data: gzipped(t, testWords)},
+	}
+	for _, path := range []string{"words.txt", "words.txt.gz"} {
+		gen, err := NewPassphraseGeneratorFromFS(fsys, path, 3, 4)
+		if err != nil {
+			t.Fatalf("Error generating passphrase generator from %s: %v", path, err)
+		}
+		checkDict(t, gen, "fig", "kiwi")
+	}
+
+	_, err := NewPassphraseGeneratorFromFS(fsys, "missing.txt", 3, 4)
+	var derr *DictionaryError
+	if !errors.As(err, &derr) || derr.Path != "missing.txt" || !errors.Is(err, fs.ErrNotExist) {
+		t.Error("Expected a dictionary error for a missing file", err)
+	}
+}
+
+func TestPassphraseGeneratorFromWords(t *testing.T) {
+	gen, err := NewPassphraseGeneratorFromWords(strings.Fields(testWords), 5, 6)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	checkDict(t, gen, "apple", "banana", "cherry")
+	p, err := gen.Passphrase(3)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if len(strings.Split(p, " ")) != 3 {
+		t.Errorf("Incorrect sized passphrase returned: %q", p)
+	}
+
+	if _, err := NewPassphraseGeneratorFromWords(nil, 5, 6); !errors.Is(err, ErrEmptyDictionary) {
+		t.Error("Expected an empty dictionary error", err)
+	}
+}
+
+func TestCompressedDicewareList(t *testing.T) {
+	l, err := ParseDicewareList(bytes.NewReader(zstded(t, dicewareFixture(""))), "fixture")
+	if err != nil {
+		t.Fatal("Error parsing diceware list", err)
+	}
+	if l.Len() != 36 {
+		t.Errorf("Incorrect list size: %d", l.Len())
+	}
+}
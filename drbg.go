@@ -0,0 +1,140 @@
+package passgen
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+
+	"golang.org/x/crypto/chacha20"
+)
+
+// Number of bytes a DRBG generates from one seed unless another interval is given to NewDRBG
+const DefaultReseedInterval = 1 << 24
+
+// Size of the buffer used by NewBufferedEntropySource unless another size is given
+const DefaultBufferSize = 4096
+
+// DRBG is a deterministic random bit generator for generating secrets in bulk, such as millions of one-time codes.
+// It generates random data with ChaCha20, keyed from a seed source - crypto/rand.Reader by default -
+// and takes a new key from the seed source every reseed interval, so reads from the seed source are rare.
+// A DRBG is safe for concurrent use by multiple goroutines, and can be given to SetEntropySource or WithEntropySource
+type DRBG struct {
+	mu       sync.Mutex
+	seed     io.Reader
+	interval int
+	left     int
+	cipher   *chacha20.Cipher
+	key      [chacha20.KeySize]byte
+}
+
+// Create a new DRBG keyed from seed, or from crypto/rand.Reader if seed is nil, taking a new key every reseedInterval bytes.
+// A reseedInterval of 0 uses DefaultReseedInterval.
+// Seeds known not to be cryptographically secure are refused with ErrInsecureEntropySource unless wrapped with InsecureEntropySource
+func NewDRBG(seed io.Reader, reseedInterval int) (*DRBG, error) {
+	if seed == nil {
+		seed = rand.Reader
+	}
+	if err := checkEntropySource(seed); err != nil {
+		return nil, err
+	}
+	if reseedInterval == 0 {
+		reseedInterval = DefaultReseedInterval
+	}
+	// ChaCha20 can only generate 2^32 blocks of 64 bytes from a key
+	if reseedInterval < 0 || int64(reseedInterval) > 1<<38 {
+		return nil, fmt.Errorf("%w: reseed interval must be from 1 to 2^38 bytes", ErrInvalidLength)
+	}
+	return &DRBG{seed: seed, interval: reseedInterval}, nil
+}
+
+// Read fills b with random data. Returns an EntropyError if a new key couldn't be read from the seed source
+func (d *DRBG) Read(b []byte) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	n := 0
+	for n < len(b) {
+		if d.left == 0 {
+			if err := d.reseed(); err != nil {
+				return n, err
+			}
+		}
+		chunk := b[n:]
+		if len(chunk) > d.left {
+			chunk = chunk[:d.left]
+		}
+		wipe(chunk)
+		d.cipher.XORKeyStream(chunk, chunk)
+		d.left -= len(chunk)
+		n += len(chunk)
+	}
+	return n, nil
+}
+
+// Reseed takes a new key from the seed source straight away, rather than waiting for the reseed interval,
+// such as after a process has been forked or restored from a snapshot
+func (d *DRBG) Reseed() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.reseed()
+}
+
+// Take a new key from the seed source. Each key is only used once, so the nonce is always zero
+func (d *DRBG) reseed() error {
+	defer wipe(d.key[:])
+	if _, err := io.ReadFull(d.seed, d.key[:]); err != nil {
+		d.cipher, d.left = nil, 0
+		return &EntropyError{Err: err}
+	}
+	var nonce [chacha20.NonceSize]byte
+	c, err := chacha20.NewUnauthenticatedCipher(d.key[:], nonce[:])
+	if err != nil {
+		return &EntropyError{Err: err}
+	}
+	d.cipher, d.left = c, d.interval
+	return nil
+}
+
+// An entropy source that reads random data from another reader in large blocks
+type bufferedReader struct {
+	mu  sync.Mutex
+	r   io.Reader
+	buf []byte
+	pos int
+}
+
+// NewBufferedEntropySource wraps an entropy source so it is read size bytes at a time, or DefaultBufferSize bytes if size is 0.
+// Generators read a few bytes at a time, so this saves a system call for each read from crypto/rand.Reader or a hardware generator.
+// Random data is wiped from the buffer as it is used. The wrapped reader is safe for concurrent use by multiple goroutines.
+// Readers known not to be cryptographically secure are refused with ErrInsecureEntropySource unless wrapped with InsecureEntropySource
+func NewBufferedEntropySource(r io.Reader, size int) (io.Reader, error) {
+	if err := checkEntropySource(r); err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		size = DefaultBufferSize
+	}
+	if size < 0 {
+		return nil, fmt.Errorf("%w: buffer size must be positive", ErrInvalidLength)
+	}
+	return &bufferedReader{r: r, buf: make([]byte, size), pos: size}, nil
+}
+
+func (b *bufferedReader) Read(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	n := 0
+	for n < len(p) {
+		if b.pos == len(b.buf) {
+			if _, err := io.ReadFull(b.r, b.buf); err != nil {
+				return n, err
+			}
+			b.pos = 0
+		}
+		c := copy(p[n:], b.buf[b.pos:])
+		wipe(b.buf[b.pos : b.pos+c])
+		b.pos += c
+		n += c
+	}
+	return n, nil
+}
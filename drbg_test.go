@@ -0,0 +1,261 @@
+package passgen
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+	mathrand "math/rand"
+	"sync"
+	"testing"
+)
+
+func TestDRBG(t *testing.T) {
+	// ChaCha20 keystream for an all zero key and nonce
+	want, _ := hex.DecodeString("76b8e0ada0f13d90405d6ae55386bd28bdd219b8a08ded1aa836efcc8b770dc7da41597c5157488d7724e03fb8d84a376a43b8f41518a11cc387b669b2ee6586")
+
+	seed := append(make([]byte, 32), bytes.Repeat([]byte{1}, 32)...)
+	d, err := NewDRBG(InsecureEntropySource(bytes.NewReader(seed)), 64)
+	if err != nil {
+		t.Fatal("Error creating DRBG", err)
+	}
+	// Read in uneven pieces to check the keystream carries on between reads
+	got := make([]byte, 64)
+	if _, err := io.ReadFull(d, got[:5]); err != nil {
+		t.Fatal("Error reading from DRBG", err)
+	}
+	if _, err := io.ReadFull(d, got[5:]); err != nil {
+		t.Fatal("Error reading from DRBG", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("DRBG generated %x, should be %x", got, want)
+	}
+
+	// The next read passes the reseed interval, so uses the second key
+	next := make([]byte, 64)
+	if _, err := io.ReadFull(d, next); err != nil {
+		t.Fatal("Error reading from DRBG", err)
+	}
+	if bytes.Equal(next, want) {
+		t.Error("DRBG should take a new key after the reseed interval")
+	}
+
+	// The seed is used up, so the next key can't be read
+	if _, err := d.Read(make([]byte, 1)); !errors.Is(err, ErrEntropySource) {
+		t.Errorf("DRBG with no seed left returned %v, should return an entropy error", err)
+	}
+	if err := d.Reseed(); !errors.Is(err, ErrEntropySource) {
+		t.Errorf("Reseed with no seed left returned %v, should return an entropy error", err)
+	}
+}
+
+func TestNewDRBG(t *testing.T) {
+	if _, err := NewDRBG(mathrand.New(mathrand.NewSource(1)), 0); !errors.Is(err, ErrInsecureEntropySource) {
+		t.Errorf("NewDRBG with an insecure seed returned %v, should return ErrInsecureEntropySource", err)
+	}
+	if _, err := NewDRBG(nil, -1); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("NewDRBG with a negative reseed interval returned %v, should return ErrInvalidLength", err)
+	}
+
+	d, err := NewDRBG(nil, 0)
+	if err != nil {
+		t.Fatal("Error creating DRBG", err)
+	}
+	if d.seed != rand.Reader || d.interval != DefaultReseedInterval {
+		t.Error("NewDRBG should default to crypto/rand.Reader and DefaultReseedInterval")
+	}
+	a, b := make([]byte, 32), make([]byte, 32)
+	d.Read(a)
+	d.Read(b)
+	if bytes.Equal(a, b) {
+		t.Error("DRBG repeated its output")
+	}
+}
+
+func TestBufferedEntropySource(t *testing.T) {
+	data := make([]byte, 100)
+	for i := range data {
+		data[i] = byte(i + 1)
+	}
+	r, err := NewBufferedEntropySource(InsecureEntropySource(bytes.NewReader(data)), 16)
+	if err != nil {
+		t.Fatal("Error creating buffered entropy source", err)
+	}
+	buffered := r.(*bufferedReader)
+
+	got := make([]byte, 0, len(data))
+	for _, n := range []int{1, 3, 20, 16, 40} {
+		b := make([]byte, n)
+		if _, err := io.ReadFull(r, b); err != nil {
+			t.Fatal("Error reading from buffered entropy source", err)
+		}
+		got = append(got, b...)
+		if !bytes.Equal(buffered.buf[:buffered.pos], make([]byte, buffered.pos)) {
+			t.Error("Buffered entropy source should wipe random data once it is used")
+		}
+	}
+	if !bytes.Equal(got, data[:len(got)]) {
+		t.Errorf("Buffered entropy source returned %v, should return %v", got, data[:len(got)])
+	}
+	// The buffer is filled once more, then only 4 bytes are left, which isn't enough to fill it again
+	if _, err := io.ReadFull(r, make([]byte, 16)); err != nil {
+		t.Fatal("Error reading from buffered entropy source", err)
+	}
+	if _, err := r.Read(make([]byte, 1)); err == nil {
+		t.Error("Buffered entropy source should fail once its reader runs out")
+	}
+
+	if _, err := NewBufferedEntropySource(bytes.NewReader(data), 0); !errors.Is(err, ErrInsecureEntropySource) {
+		t.Errorf("NewBufferedEntropySource with an insecure reader returned %v, should return ErrInsecureEntropySource", err)
+	}
+	if _, err := NewBufferedEntropySource(rand.Reader, -1); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("NewBufferedEntropySource with a negative size returned %v, should return ErrInvalidLength", err)
+	}
+}
+
+// randInt must give the same results as crypto/rand.Int for the same random data
+func TestRandIntMatchesRandInt(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 10, 26, 95, 255, 256, 257, 7776, 65536, 1<<31 - 1} {
+		a := InsecureEntropySource(mathrand.New(mathrand.NewSource(int64(n))))
+		b := InsecureEntropySource(mathrand.New(mathrand.NewSource(int64(n))))
+		for i := 0; i < 100; i++ {
+			got, err := randInt(a, n)
+			if err != nil {
+				t.Fatal("Error getting random number", err)
+			}
+			want, _ := rand.Int(b, big.NewInt(int64(n)))
+			if int64(got) != want.Int64() {
+				t.Fatalf("randInt(%d) returned %d, crypto/rand.Int returned %d", n, got, want)
+			}
+		}
+	}
+}
+
+func TestDRBGEntropySource(t *testing.T) {
+	d, err := NewDRBG(nil, 1024)
+	if err != nil {
+		t.Fatal("Error creating DRBG", err)
+	}
+	gen, err := NewPassword(WithCharset(Digits), WithLength(8, 8), WithEntropySource(d))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if gen.rand != d {
+		t.Error("A DRBG is safe for concurrent use, so it shouldn't be wrapped")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				s, err := gen.GeneratePassword(8, 8)
+				if err != nil || len(s) != 8 {
+					t.Errorf("GeneratePassword returned %q, %v", s, err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestGeneratePasswordAllocations(t *testing.T) {
+	d, err := NewDRBG(nil, 0)
+	if err != nil {
+		t.Fatal("Error creating DRBG", err)
+	}
+	gen, err := NewPassword(WithEntropySource(d))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	// Allocations must not grow with the length of the password
+	dst := make([]byte, 1000)
+	allocs := testing.AllocsPerRun(100, func() {
+		gen.generatePassword(dst, d)
+	})
+	if allocs > 1 {
+		t.Errorf("generatePassword made %v allocations, should only allocate its random data buffer", allocs)
+	}
+	allocs = testing.AllocsPerRun(100, func() {
+		randInt(d, 95)
+	})
+	if allocs > 0 {
+		t.Errorf("randInt made %v allocations, should make none", allocs)
+	}
+}
+
+// Generate 8 digit one-time codes, as when pre-generating codes in bulk
+func benchmarkOneTimeCodes(b *testing.B, r io.Reader) {
+	gen, err := NewPassword(WithCharset(Digits), WithLength(8, 8), WithEntropySource(r))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.SetBytes(8)
+	for i := 0; i < b.N; i++ {
+		if _, err := gen.GeneratePassword(8, 8); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkOneTimeCodes(b *testing.B) {
+	b.Run("crypto-rand", func(b *testing.B) {
+		benchmarkOneTimeCodes(b, rand.Reader)
+	})
+	b.Run("buffered", func(b *testing.B) {
+		r, _ := NewBufferedEntropySource(rand.Reader, 0)
+		benchmarkOneTimeCodes(b, r)
+	})
+	b.Run("drbg", func(b *testing.B) {
+		d, _ := NewDRBG(nil, 0)
+		benchmarkOneTimeCodes(b, d)
+	})
+}
+
+// Compare the ways of filling a long password from each entropy source
+func BenchmarkGeneratePasswordPaths(b *testing.B) {
+	gen := GetSecurePasswordGenerator()
+	dst := make([]byte, 1024)
+	buffered, _ := NewBufferedEntropySource(rand.Reader, 0)
+	drbg, _ := NewDRBG(nil, 0)
+
+	b.Run("generatePassword2", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(dst)))
+		for i := 0; i < b.N; i++ {
+			if _, err := gen.generatePassword2(dst); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	for _, src := range []struct {
+		name string
+		r    io.Reader
+	}{{"crypto-rand", rand.Reader}, {"buffered", buffered}, {"drbg", drbg}} {
+		b.Run("generatePassword/"+src.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(dst)))
+			for i := 0; i < b.N; i++ {
+				if _, err := gen.generatePassword(dst, src.r); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run("randInt/"+src.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(dst)))
+			for i := 0; i < b.N; i++ {
+				for range dst {
+					if _, err := randInt(src.r, 95); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
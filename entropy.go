@@ -0,0 +1,293 @@
+package passgen
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math"
+	mathrand "math/rand"
+	"strings"
+	"sync"
+)
+
+// An entropy source that the caller has explicitly allowed even though it isn't secure
+type insecureReader struct {
+	io.Reader
+}
+
+// InsecureEntropySource marks a reader as allowed to be used as an entropy source even if it isn't cryptographically secure.
+// Passwords and passphrases generated from it are only as unpredictable as the reader; use it only for tests or reproducible output
+func InsecureEntropySource(r io.Reader) io.Reader {
+	return insecureReader{r}
+}
+
+// Check that a reader is suitable to be used as an entropy source
+func checkEntropySource(r io.Reader) error {
+	switch r.(type) {
+	case nil:
+		return fmt.Errorf("%w: reader is nil", ErrInsecureEntropySource)
+	case *mathrand.Rand, *bytes.Reader, *bytes.Buffer, *strings.Reader:
+		return ErrInsecureEntropySource
+	}
+	return nil
+}
+
+// An entropy source shared by every goroutine using a generator.
+// Reads are serialized, since most readers aren't safe for concurrent use
+type lockedReader struct {
+	mu sync.Mutex
+	r  io.Reader
+}
+
+func (l *lockedReader) Read(b []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Read(b)
+}
+
+// Get the reader a generator keeps for an entropy source. crypto/rand.Reader, DRBGs and buffered entropy sources are already safe for concurrent use
+func sharedEntropySource(r io.Reader) io.Reader {
+	switch r.(type) {
+	case *DRBG, *bufferedReader:
+		return r
+	}
+	if r == rand.Reader {
+		return r
+	}
+	return &lockedReader{r: r}
+}
+
+// Get the reader to use for random data, defaulting to crypto/rand.Reader
+func entropySource(r io.Reader) io.Reader {
+	if r == nil {
+		return rand.Reader
+	}
+	return r
+}
+
+// Entropy returns the entropy, in bits, of the passwords created by GeneratePassword(min, max).
+// Because the length of each password is chosen at random, the randomness of the length is included.
+//
+// With a Policy the result is a lower bound: each required character is counted as drawn from its character class,
+// each remaining character as drawn from the alphabet less any classes with a maximum,
+// and the extra randomness added by shuffling the required characters into place is ignored.
+func (p *PasswordGenerator) Entropy(min, max int) (float64, error) {
+	min, max, bits, err := p.lengthBits(min, max)
+	if err != nil {
+		return 0, err
+	}
+	return lengthEntropy(min, max, bits), nil
+}
+
+// CollisionEntropy returns the collision entropy, in bits, of the passwords created by GeneratePassword(min, max):
+// -log2 of the chance that two of them are the same. It is the same as Entropy when min and max are equal,
+// and lower when they aren't, as short passwords are far more likely to repeat than long ones.
+// CollisionProbability needs the collision entropy to be accurate
+func (p *PasswordGenerator) CollisionEntropy(min, max int) (float64, error) {
+	min, max, bits, err := p.lengthBits(min, max)
+	if err != nil {
+		return 0, err
+	}
+	return lengthCollisionEntropy(min, max, bits), nil
+}
+
+// Get the shortest and longest lengths of the passwords created by GeneratePassword(min, max), which a Policy can change,
+// and a function giving the entropy of the passwords of each length
+func (p *PasswordGenerator) lengthBits(min, max int) (int, int, func(length int) float64, error) {
+	if err := checkLength(min, max); err != nil {
+		return 0, 0, nil, err
+	}
+	a, err := p.alphabet()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if p.policy == nil {
+		return min, max, func(length int) float64 {
+			return float64(length) * math.Log2(float64(len(a.chars)))
+		}, nil
+	}
+
+	alphabet := p.Charset()
+	min, max, err = p.policy.checkLength(alphabet, min, max)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return min, max, func(length int) float64 {
+		return p.policy.entropy(alphabet, length)
+	}, nil
+}
+
+// Entropy returns the entropy, in bits, of the passwords created by GeneratePassword(min, max).
+// Because the length of each password is chosen at random, the randomness of the length is included.
+func (p *RunePasswordGenerator) Entropy(min, max int) (float64, error) {
+	if err := checkLength(min, max); err != nil {
+		return 0, err
+	}
+	return lengthEntropy(min, max, func(length int) float64 {
+		return float64(length) * math.Log2(float64(len(p.chars)))
+	}), nil
+}
+
+// CollisionEntropy returns the collision entropy, in bits, of the passwords created by GeneratePassword(min, max):
+// -log2 of the chance that two of them are the same. See PasswordGenerator.CollisionEntropy
+func (p *RunePasswordGenerator) CollisionEntropy(min, max int) (float64, error) {
+	if err := checkLength(min, max); err != nil {
+		return 0, err
+	}
+	return lengthCollisionEntropy(min, max, func(length int) float64 {
+		return float64(length) * math.Log2(float64(len(p.chars)))
+	}), nil
+}
+
+// Entropy returns the entropy, in bits, of the passphrases created by Passphrase(numWords),
+// based on the number of words left in the dictionary after filtering and the random elements added by the generator's Format
+func (p *PassphraseGenerator) Entropy(numWords int) float64 {
+	if len(p.dict) == 0 {
+		return 0
+	}
+	return float64(numWords)*math.Log2(float64(len(p.dict))) + p.Format.entropy(numWords, float64(p.cased)/float64(len(p.dict)))
+}
+
+// CollisionEntropy returns the collision entropy, in bits, of the passphrases created by Passphrase(numWords):
+// -log2 of the chance that two of them are the same. It is the same as Entropy unless the Format uses RandomCase
+// with a dictionary that has words without case, which come out one way where other words come out two
+func (p *PassphraseGenerator) CollisionEntropy(numWords int) float64 {
+	bits := p.Entropy(numWords)
+	if len(p.dict) == 0 || p.Format.Capitalization != RandomCase {
+		return bits
+	}
+	// Each caseless word has a 1/n chance and each cased one 1/2n for each case, so two words match with a chance of (n - cased/2)/n²
+	n, cased := float64(len(p.dict)), float64(p.cased)
+	perWord := math.Log2(n) + cased/n
+	collision := 2*math.Log2(n) - math.Log2(n-cased/2)
+	return bits + float64(numWords)*(collision-perWord)
+}
+
+// LengthForEntropy returns the shortest password length that gives at least the given number of bits of entropy
+func (p *PasswordGenerator) LengthForEntropy(bits float64) (int, error) {
+	if bits <= 0 {
+		return 0, ErrInvalidEntropy
+	}
+	a, err := p.alphabet()
+	if err != nil {
+		return 0, err
+	}
+	if p.policy == nil {
+		return countForEntropy(bits, math.Log2(float64(len(a.chars))))
+	}
+
+	// Policy entropy grows by the same amount for each character past the required ones
+	alphabet := p.Charset()
+	required := 0
+	for _, r := range p.policy.Requirements {
+		required += r.Min
+	}
+	if _, _, err := p.policy.checkLength(alphabet, required, required); err != nil {
+		return 0, err
+	}
+	base := p.policy.entropy(alphabet, required)
+	if base >= bits {
+		return required, nil
+	}
+	n, err := countForEntropy(bits-base, p.policy.entropy(alphabet, required+1)-base)
+	if err != nil {
+		return 0, err
+	}
+	// The length must still be allowed by the Policy's maximums
+	if _, _, err := p.policy.checkLength(alphabet, required+n, required+n); err != nil {
+		return 0, err
+	}
+	return required + n, nil
+}
+
+// GenerateWithEntropy creates a password of the shortest length that gives at least the given number of bits of entropy
+func (p *PasswordGenerator) GenerateWithEntropy(bits float64) (string, error) {
+	length, err := p.LengthForEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+	return p.GeneratePassword(length, length)
+}
+
+// LengthForEntropy returns the shortest password length, in characters, that gives at least the given number of bits of entropy
+func (p *RunePasswordGenerator) LengthForEntropy(bits float64) (int, error) {
+	if bits <= 0 {
+		return 0, ErrInvalidEntropy
+	}
+	return countForEntropy(bits, math.Log2(float64(len(p.chars))))
+}
+
+// GenerateWithEntropy creates a password of the shortest length that gives at least the given number of bits of entropy
+func (p *RunePasswordGenerator) GenerateWithEntropy(bits float64) (string, error) {
+	length, err := p.LengthForEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+	return p.GeneratePassword(length, length)
+}
+
+// WordsForEntropy returns the smallest number of words that gives a passphrase at least the given number of bits of entropy
+func (p *PassphraseGenerator) WordsForEntropy(bits float64) (int, error) {
+	if bits <= 0 {
+		return 0, ErrInvalidEntropy
+	}
+	if len(p.dict) < 2 {
+		return 0, ErrDictionaryTooSmall
+	}
+	if err := p.Format.check(); err != nil {
+		return 0, err
+	}
+	// Each word adds at least as much entropy as choosing it from the dictionary, so this is bounded
+	n := 1
+	for p.Entropy(n) < bits {
+		n++
+	}
+	return n, nil
+}
+
+// GenerateWithEntropy creates a passphrase with the smallest number of words that gives at least the given number of bits of entropy
+func (p *PassphraseGenerator) GenerateWithEntropy(bits float64) (string, error) {
+	n, err := p.WordsForEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+	return p.Passphrase(n)
+}
+
+// Get how many symbols, each adding perSymbol bits, are needed to reach the given bits
+func countForEntropy(bits, perSymbol float64) (int, error) {
+	if perSymbol <= 0 {
+		return 0, ErrAlphabetTooSmall
+	}
+	// Allow for floating point error when bits is an exact multiple of perSymbol
+	return int(math.Ceil(bits/perSymbol - 1e-9)), nil
+}
+
+// Get the entropy of a secret whose length is chosen uniformly between min and max,
+// given the entropy of a secret of each length
+func lengthEntropy(min, max int, bits func(length int) float64) float64 {
+	total := 0.0
+	for length := min; length <= max; length++ {
+		total += bits(length)
+	}
+	n := float64(max - min + 1)
+	return math.Log2(n) + total/n
+}
+
+// Get the collision entropy of a secret whose length is chosen uniformly between min and max,
+// given the entropy of the equally likely secrets of each length.
+// Two secrets match with a chance of the sum over the lengths of 2^-bits(length) / n², for n lengths
+func lengthCollisionEntropy(min, max int, bits func(length int) float64) float64 {
+	// Factor out the smallest 2^-bits, so long secrets don't underflow to 0
+	lowest := math.Inf(1)
+	for length := min; length <= max; length++ {
+		lowest = math.Min(lowest, bits(length))
+	}
+	total := 0.0
+	for length := min; length <= max; length++ {
+		total += math.Exp2(lowest - bits(length))
+	}
+	n := float64(max - min + 1)
+	return lowest - math.Log2(total) + 2*math.Log2(n)
+}
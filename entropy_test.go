@@ -0,0 +1,204 @@
+package passgen
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"math"
+	mathrand "math/rand"
+	"strings"
+	"testing"
+)
+
+func TestEntropySource(t *testing.T) {
+	gen := GetSecurePasswordGenerator()
+	if err := gen.SetEntropySource(rand.Reader); err != nil {
+		t.Fatal("Error setting entropy source", err)
+	}
+	if _, err := gen.GeneratePassword(14, 20); err != nil {
+		t.Fatal("Error generating password", err)
+	}
+}
+
+func TestInsecureEntropySource(t *testing.T) {
+	gen := GetSecurePasswordGenerator()
+	if err := gen.SetEntropySource(mathrand.New(mathrand.NewSource(1))); !errors.Is(err, ErrInsecureEntropySource) {
+		t.Error("Expected math/rand to be refused as an entropy source", err)
+	}
+	if err := gen.SetEntropySource(bytes.NewReader(nil)); !errors.Is(err, ErrInsecureEntropySource) {
+		t.Error("Expected a bytes.Reader to be refused as an entropy source", err)
+	}
+	if err := gen.SetEntropySource(nil); err == nil {
+		t.Error("Expected a nil entropy source to be refused")
+	}
+}
+
+func TestDeterministicEntropySource(t *testing.T) {
+	generate := func() (string, string) {
+		gen := GetAlphaNumericPasswordGenerator()
+		if err := gen.SetEntropySource(InsecureEntropySource(mathrand.New(mathrand.NewSource(42)))); err != nil {
+			t.Fatal("Error setting entropy source", err)
+		}
+		password, err := gen.GeneratePassword(14, 20)
+		if err != nil {
+			t.Fatal("Error generating password", err)
+		}
+
+		phraseGen, err := GetXKCDPassphraseGenerator()
+		if err != nil {
+			t.Fatal("Error generating passphrase generator", err)
+		}
+		if err := phraseGen.SetEntropySource(InsecureEntropySource(mathrand.New(mathrand.NewSource(42)))); err != nil {
+			t.Fatal("Error setting entropy source", err)
+		}
+		phrase, err := phraseGen.Passphrase(4)
+		if err != nil {
+			t.Fatal("Error generating passphrase", err)
+		}
+		return password, phrase
+	}
+
+	p1, w1 := generate()
+	p2, w2 := generate()
+	if p1 != p2 || w1 != w2 {
+		t.Error("A deterministic entropy source should generate the same secrets")
+	}
+}
+
+func TestExhaustedEntropySource(t *testing.T) {
+	gen := GetSecurePasswordGenerator()
+	if err := gen.SetEntropySource(InsecureEntropySource(bytes.NewReader([]byte{1, 2, 3}))); err != nil {
+		t.Fatal("Error setting entropy source", err)
+	}
+	if _, err := gen.GeneratePassword(14, 14); err == nil {
+		t.Error("Expected an error when the entropy source runs out")
+	}
+}
+
+func closeTo(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestPasswordEntropy(t *testing.T) {
+	bits, err := GetNumericPasswordGenerator().Entropy(10, 10)
+	if err != nil {
+		t.Fatal("Error calculating entropy", err)
+	}
+	if !closeTo(bits, 10*math.Log2(10)) {
+		t.Errorf("Incorrect entropy. Expected: %f\t Actual: %f", 10*math.Log2(10), bits)
+	}
+
+	// A length chosen from 4 possibilities adds 2 bits
+	bits, err = GetAlphaLowerPasswordGenerator().Entropy(8, 11)
+	if err != nil {
+		t.Fatal("Error calculating entropy", err)
+	}
+	expected := 2 + 9.5*math.Log2(26)
+	if !closeTo(bits, expected) {
+		t.Errorf("Incorrect entropy. Expected: %f\t Actual: %f", expected, bits)
+	}
+
+	if _, err := GetSecurePasswordGenerator().Entropy(10, 8); err == nil {
+		t.Error("Expected an error for an invalid length range")
+	}
+}
+
+func TestPolicyPasswordEntropy(t *testing.T) {
+	gen := GetAlphaNumericPasswordGenerator()
+	if err := gen.SetPolicy(&Policy{Requirements: []Requirement{{Charset: Digits, Min: 2}}}); err != nil {
+		t.Fatal("Error setting policy", err)
+	}
+	bits, err := gen.Entropy(8, 8)
+	if err != nil {
+		t.Fatal("Error calculating entropy", err)
+	}
+	expected := 2*math.Log2(10) + 6*math.Log2(62)
+	if !closeTo(bits, expected) {
+		t.Errorf("Incorrect entropy. Expected: %f\t Actual: %f", expected, bits)
+	}
+}
+
+func TestPassphraseEntropy(t *testing.T) {
+	gen, err := GetXKCDPassphraseGenerator()
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	expected := 4 * math.Log2(float64(len(gen.dict)))
+	if bits := gen.Entropy(4); !closeTo(bits, expected) {
+		t.Errorf("Incorrect entropy. Expected: %f\t Actual: %f", expected, bits)
+	}
+}
+
+func TestGenerateWithEntropy(t *testing.T) {
+	gen := GetNumericPasswordGenerator()
+	length, err := gen.LengthForEntropy(80)
+	if err != nil {
+		t.Fatal("Error calculating length", err)
+	}
+	// 24 digits is 79.7 bits
+	if length != 25 {
+		t.Errorf("Incorrect length. Expected: %d\t Actual: %d", 25, length)
+	}
+	p, err := gen.GenerateWithEntropy(80)
+	if err != nil {
+		t.Fatal("Error generating password", err)
+	}
+	if len(p) != 25 {
+		t.Error("Incorrect sized password returned")
+	}
+
+	// Exact multiples shouldn't round up
+	gen, err = NewPasswordGeneratorFromCharset(CharRange('a', 'p'))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if length, _ := gen.LengthForEntropy(128); length != 32 {
+		t.Errorf("Incorrect length. Expected: %d\t Actual: %d", 32, length)
+	}
+
+	if _, err := gen.LengthForEntropy(0); err == nil {
+		t.Error("Expected an error for zero bits")
+	}
+	gen, _ = NewPasswordGeneratorFromCharset(NewCharset("a"))
+	if _, err := gen.LengthForEntropy(10); err == nil {
+		t.Error("Expected an error for a single character alphabet")
+	}
+}
+
+func TestPolicyGenerateWithEntropy(t *testing.T) {
+	gen := GetAlphaNumericPasswordGenerator()
+	if err := gen.SetPolicy(&Policy{Requirements: []Requirement{{Charset: Digits, Min: 4}}}); err != nil {
+		t.Fatal("Error setting policy", err)
+	}
+	length, err := gen.LengthForEntropy(60)
+	if err != nil {
+		t.Fatal("Error calculating length", err)
+	}
+	if bits, _ := gen.Entropy(length, length); bits < 60 {
+		t.Errorf("Length %d only gives %f bits", length, bits)
+	}
+	if bits, _ := gen.Entropy(length-1, length-1); bits >= 60 {
+		t.Errorf("Length %d is longer than needed", length)
+	}
+}
+
+func TestPassphraseGenerateWithEntropy(t *testing.T) {
+	gen, err := GetXKCDPassphraseGenerator()
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	n, err := gen.WordsForEntropy(80)
+	if err != nil {
+		t.Fatal("Error calculating words", err)
+	}
+	if gen.Entropy(n) < 80 || gen.Entropy(n-1) >= 80 {
+		t.Errorf("Incorrect number of words: %d", n)
+	}
+	p, err := gen.GenerateWithEntropy(80)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if len(strings.Split(p, " ")) != n {
+		t.Errorf("Incorrect sized passphrase returned: %q", p)
+	}
+}
@@ -0,0 +1,142 @@
+package passgen
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors caused by how a generator was configured or called. Retrying with the same settings will fail the same way
+var (
+	// ErrInvalidLength is matched by errors caused by a length range or word count that can't be used
+	ErrInvalidLength = errors.New("Invalid length")
+	// ErrInvalidEntropy is returned when a requested entropy is not a positive number of bits
+	ErrInvalidEntropy = errors.New("Entropy must be positive")
+	// ErrInvalidCharset is matched by errors caused by a Charset that can't be used by a generator
+	ErrInvalidCharset = errors.New("Invalid charset")
+	// ErrAlphabetTooSmall is returned when a generator's alphabet has too few characters to reach the requested entropy
+	ErrAlphabetTooSmall = errors.New("Alphabet is too small to reach the requested entropy")
+	// ErrEmptyDictionary is returned when no words in the dictionary meet the generator's word length requirements
+	ErrEmptyDictionary = errors.New("Dictionary has no usable words")
+	// ErrDictionaryTooSmall is returned when the dictionary has too few words to reach the requested entropy
+	ErrDictionaryTooSmall = errors.New("Dictionary is too small to reach the requested entropy")
+	// ErrInvalidDicewareList is wrapped by DictionaryErrors for Diceware lists that are malformed or incomplete
+	ErrInvalidDicewareList = errors.New("Invalid Diceware list")
+	// ErrInvalidRoll is matched by errors caused by a dice roll that doesn't match the Diceware list
+	ErrInvalidRoll = errors.New("Invalid dice roll")
+	// ErrNoDicewareList is returned when dice rolls are used with a generator that wasn't created from a Diceware list
+	ErrNoDicewareList = errors.New("Passphrase generator was not created from a Diceware list")
+	// ErrUnsupportedLanguage is matched by errors for language tags that no built-in word list matches
+	ErrUnsupportedLanguage = errors.New("No built-in word list for language")
+	// ErrUnknownWordList is matched by errors for word list names that aren't built in
+	ErrUnknownWordList = errors.New("Unknown word list")
+	// ErrUnknownProfile is matched by errors for generator profile names that aren't registered
+	ErrUnknownProfile = errors.New("Unknown generator profile")
+	// ErrDuplicateProfile is matched by errors for registering a profile under a name that is already taken
+	ErrDuplicateProfile = errors.New("Generator profile is already registered")
+	// ErrInvalidProfile is matched by errors for registering a profile that is missing its name or New function
+	ErrInvalidProfile = errors.New("Invalid generator profile")
+	// ErrInsecureEntropySource is returned when a reader that is known not to be cryptographically secure is used as an entropy source
+	ErrInsecureEntropySource = errors.New("Entropy source is not cryptographically secure")
+	// ErrTooManyDuplicates is returned when a batch of unique secrets keeps generating secrets it has already generated,
+	// because the generator can't create enough different secrets
+	ErrTooManyDuplicates = errors.New("Too many duplicate secrets")
+	// ErrSpaceTooSmall is matched by errors for unique batches that ask for more secrets than the generator can reasonably create
+	ErrSpaceTooSmall = errors.New("Not enough possible secrets for a unique batch")
+	// ErrPolicyUnsatisfiable is matched by all PolicyErrors
+	ErrPolicyUnsatisfiable = errors.New("Password policy cannot be satisfied")
+)
+
+// Errors caused by failures of the system the generator runs on. The underlying cause is wrapped and can be inspected with errors.Unwrap
+var (
+	// ErrEntropySource is matched by all EntropyErrors
+	ErrEntropySource = errors.New("Unable to read from entropy source")
+	// ErrDictionary is matched by all DictionaryErrors
+	ErrDictionary = errors.New("Unable to load dictionary")
+)
+
+// LengthError is returned when a maximum length is smaller than the minimum length
+type LengthError struct {
+	Min, Max int
+}
+
+func (e *LengthError) Error() string {
+	return fmt.Sprintf("Max length (%d) must be larger than min length (%d)", e.Max, e.Min)
+}
+
+// Is allows LengthErrors to be matched against ErrInvalidLength
+func (e *LengthError) Is(target error) bool {
+	return target == ErrInvalidLength
+}
+
+// Check that min and max make a valid length range
+func checkLength(min, max int) error {
+	if min < 0 || max < min {
+		return &LengthError{Min: min, Max: max}
+	}
+	return nil
+}
+
+// PolicyError describes why a Policy cannot be satisfied by a generator
+type PolicyError struct {
+	// Index of the Requirement that cannot be satisfied, or -1 if the Policy as a whole cannot be satisfied
+	Requirement int
+	// Description of the problem
+	Reason string
+}
+
+func (e *PolicyError) Error() string {
+	if e.Requirement < 0 {
+		return "Password policy cannot be satisfied: " + e.Reason
+	}
+	return fmt.Sprintf("Password policy requirement %d cannot be satisfied: %s", e.Requirement, e.Reason)
+}
+
+// Is allows PolicyErrors to be matched against ErrPolicyUnsatisfiable
+func (e *PolicyError) Is(target error) bool {
+	return target == ErrPolicyUnsatisfiable
+}
+
+// EntropyError is returned when random data can't be read from a generator's entropy source
+type EntropyError struct {
+	// The error returned by the entropy source
+	Err error
+}
+
+func (e *EntropyError) Error() string {
+	return "Unable to read from entropy source: " + e.Err.Error()
+}
+
+func (e *EntropyError) Unwrap() error {
+	return e.Err
+}
+
+// Is allows EntropyErrors to be matched against ErrEntropySource
+func (e *EntropyError) Is(target error) bool {
+	return target == ErrEntropySource
+}
+
+// DictionaryError is returned when a dictionary can't be opened, read or decoded
+type DictionaryError struct {
+	// Path of the dictionary file, or "internal" for the internal dictionary
+	Path string
+	// Line the error happened on, or 0 if it didn't happen while reading a line
+	Line int
+	// The underlying cause
+	Err error
+}
+
+func (e *DictionaryError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("Unable to load dictionary %s: line %d: %v", e.Path, e.Line, e.Err)
+	}
+	return fmt.Sprintf("Unable to load dictionary %s: %v", e.Path, e.Err)
+}
+
+func (e *DictionaryError) Unwrap() error {
+	return e.Err
+}
+
+// Is allows DictionaryErrors to be matched against ErrDictionary
+func (e *DictionaryError) Is(target error) bool {
+	return target == ErrDictionary
+}
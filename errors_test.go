@@ -0,0 +1,76 @@
+package passgen
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLengthError(t *testing.T) {
+	_, err := GetSecurePassword(10, 8)
+	var lerr *LengthError
+	if !errors.As(err, &lerr) || lerr.Min != 10 || lerr.Max != 8 {
+		t.Error("Expected a length error", err)
+	}
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Error("Expected a length error to match ErrInvalidLength", err)
+	}
+	if _, err := NewPassphraseGenerator("internal", 8, 4); !errors.Is(err, ErrInvalidLength) {
+		t.Error("Expected a length error", err)
+	}
+}
+
+func TestEntropyError(t *testing.T) {
+	gen := GetSecurePasswordGenerator()
+	if err := gen.SetEntropySource(failingReader{}); err != nil {
+		t.Fatal("Error setting entropy source", err)
+	}
+	_, err := gen.GeneratePassword(14, 14)
+	var eerr *EntropyError
+	if !errors.As(err, &eerr) || !errors.Is(err, ErrEntropySource) {
+		t.Error("Expected an entropy error", err)
+	}
+	if eerr != nil && eerr.Unwrap().Error() != "device unavailable" {
+		t.Error("Expected the entropy source's error to be wrapped", eerr.Unwrap())
+	}
+}
+
+func TestDictionaryError(t *testing.T) {
+	_, err := NewPassphraseGenerator("path/to/missing/dictionary", 4, 8)
+	var derr *DictionaryError
+	if !errors.As(err, &derr) || derr.Path != "path/to/missing/dictionary" {
+		t.Error("Expected a dictionary error with the path", err)
+	}
+	if !errors.Is(err, ErrDictionary) || !errors.Is(err, fs.ErrNotExist) {
+		t.Error("Expected a dictionary error wrapping the file error", err)
+	}
+}
+
+func TestDictionaryErrorLine(t *testing.T) {
+	// A line longer than the scanner allows can't be read
+	path := filepath.Join(t.TempDir(), "dict.txt")
+	contents := "apple\nbanana\n" + strings.Repeat("x", 1<<17) + "\ncherry\n"
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal("Error writing dictionary", err)
+	}
+	_, err := NewPassphraseGenerator(path, 4, 8)
+	var derr *DictionaryError
+	if !errors.As(err, &derr) {
+		t.Fatal("Expected a dictionary error", err)
+	}
+	if derr.Line != 3 {
+		t.Errorf("Incorrect line number. Expected: %d\t Actual: %d", 3, derr.Line)
+	}
+}
+
+func TestCharsetError(t *testing.T) {
+	if _, err := NewPasswordGeneratorFromCharset(Charset{}); !errors.Is(err, ErrInvalidCharset) {
+		t.Error("Expected an invalid charset error", err)
+	}
+	if _, err := NewRunePasswordGenerator(NewCharset("a\u0301"), NFC); !errors.Is(err, ErrInvalidCharset) {
+		t.Error("Expected an invalid charset error", err)
+	}
+}
@@ -0,0 +1,244 @@
+package passgen
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Capitalization controls how the words of a passphrase are capitalized
+type Capitalization int
+
+const (
+	// Leave words as they appear in the dictionary
+	NoCapitalization Capitalization = iota
+	// Capitalize the first letter of each word: Correct Horse
+	TitleCase
+	// Capitalize every letter: CORRECT HORSE
+	UpperCase
+	// Make every letter lower case: correct horse
+	LowerCase
+	// Randomly make each word either all upper or all lower case: correct HORSE. Adds 1 bit of entropy per word,
+	// less for dictionaries with words that have no case, such as Japanese kana or Korean hangul
+	RandomCase
+	// Lower case the first word and capitalize the first letter of the rest: correctHorse.
+	// Usually used with an empty Separator
+	CamelCase
+)
+
+// Placement controls where the random digits and symbols of a Format are put
+type Placement int
+
+const (
+	// Append the digits and symbols to the end of the passphrase: correct horse42!
+	AtEnd Placement = iota
+	// Put each digit and symbol at a random word boundary, including the start and end: correct4 horse!2
+	BetweenWords
+)
+
+// Default characters used for random symbols and padding - the printable ASCII symbols without space
+var defaultSymbols = Symbols.Subtract(NewCharset(" "))
+
+// Format describes how the words chosen for a passphrase are put together.
+// Every random element a Format adds is counted by the generator's Entropy.
+type Format struct {
+	// Placed between each pair of words. Ignored if Separators isn't empty
+	Separator string
+	// Characters to randomly choose a separator from for each gap between words
+	Separators Charset
+
+	// How to capitalize each word
+	Capitalization Capitalization
+
+	// Number of random digits to add
+	Digits int
+	// Number of random symbols to add
+	Symbols int
+	// Characters to choose random symbols and padding from. Uses the printable ASCII symbols, except space, if empty
+	SymbolChars Charset
+	// Where the random digits and symbols are put.
+	// The randomness of where they end up with BetweenWords is not counted in the entropy
+	Placement Placement
+
+	// Number of times to repeat a randomly chosen padding symbol at each end of the passphrase: ***correct horse***
+	Padding int
+}
+
+// The Format passphrases use unless it is changed - words as they are, separated by a space
+var DefaultFormat = Format{Separator: " "}
+
+// Get the characters to draw symbols and padding from
+func (f *Format) symbols() Charset {
+	if f.SymbolChars.Len() == 0 {
+		return defaultSymbols
+	}
+	return f.SymbolChars
+}
+
+// Check the Format can be used. Returns an error matching ErrInvalidLength if a count is negative
+func (f *Format) check() error {
+	switch {
+	case f.Digits < 0:
+		return fmt.Errorf("%w: number of digits must not be negative", ErrInvalidLength)
+	case f.Symbols < 0:
+		return fmt.Errorf("%w: number of symbols must not be negative", ErrInvalidLength)
+	case f.Padding < 0:
+		return fmt.Errorf("%w: padding must not be negative", ErrInvalidLength)
+	}
+	return nil
+}
+
+// Get the entropy, in bits, the Format adds to a passphrase of numWords words,
+// where cased is the fraction of the dictionary's words whose upper and lower case forms differ
+func (f *Format) entropy(numWords int, cased float64) float64 {
+	bits := 0.0
+	if numWords > 1 && f.Separators.Len() > 0 {
+		bits += float64(numWords-1) * math.Log2(float64(f.Separators.Len()))
+	}
+	if f.Capitalization == RandomCase {
+		// A caseless word comes out the same either way, so only the cased words add a bit
+		bits += float64(numWords) * cased
+	}
+	bits += float64(f.Digits) * math.Log2(float64(Digits.Len()))
+	symbols := math.Log2(float64(f.symbols().Len()))
+	bits += float64(f.Symbols) * symbols
+	if f.Padding > 0 {
+		bits += symbols
+	}
+	return bits
+}
+
+// Put the chosen words together into a passphrase.
+// The passphrase is built in a byte slice so the caller can wipe it, and the random digits and symbols are wiped once they have been added
+func (f *Format) apply(words []string, rand io.Reader) ([]byte, error) {
+	if err := f.check(); err != nil {
+		return nil, err
+	}
+	words = append([]string{}, words...)
+	for i, w := range words {
+		switch f.Capitalization {
+		case TitleCase:
+			words[i] = title(w)
+		case UpperCase:
+			words[i] = strings.ToUpper(w)
+		case LowerCase:
+			words[i] = strings.ToLower(w)
+		case RandomCase:
+			upper, err := randInt(rand, 2)
+			if err != nil {
+				return nil, err
+			}
+			if upper == 1 {
+				words[i] = strings.ToUpper(w)
+			} else {
+				words[i] = strings.ToLower(w)
+			}
+		case CamelCase:
+			if i == 0 {
+				words[i] = strings.ToLower(w)
+			} else {
+				words[i] = title(w)
+			}
+		}
+	}
+
+	// Random digits and symbols to add, collected for each gap around the words
+	gaps := make([][]byte, len(words)+1)
+	defer func() {
+		for _, gap := range gaps {
+			wipe(gap)
+		}
+	}()
+	addExtras := func(n int, c Charset) error {
+		for i := 0; i < n; i++ {
+			r, err := randRune(rand, c)
+			if err != nil {
+				return err
+			}
+			gap := len(gaps) - 1
+			if f.Placement == BetweenWords {
+				if gap, err = randInt(rand, len(gaps)); err != nil {
+					return err
+				}
+			}
+			gaps[gap] = utf8.AppendRune(gaps[gap], r)
+		}
+		return nil
+	}
+	if err := addExtras(f.Digits, Digits); err != nil {
+		return nil, err
+	}
+	if err := addExtras(f.Symbols, f.symbols()); err != nil {
+		return nil, err
+	}
+
+	var pad []byte
+	if f.Padding > 0 {
+		r, err := randRune(rand, f.symbols())
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < f.Padding; i++ {
+			pad = utf8.AppendRune(pad, r)
+		}
+	}
+
+	// Work out the length first, so the passphrase isn't copied as it grows
+	size := 2*len(pad) + len(gaps[0])
+	for i, w := range words {
+		size += len(w) + len(gaps[i+1])
+		if i > 0 && f.Separators.Len() > 0 {
+			size += utf8.UTFMax
+		} else if i > 0 {
+			size += len(f.Separator)
+		}
+	}
+	b := make([]byte, 0, size)
+	b = append(b, pad...)
+	b = append(b, gaps[0]...)
+	for i, w := range words {
+		if i > 0 {
+			if f.Separators.Len() > 0 {
+				r, err := randRune(rand, f.Separators)
+				if err != nil {
+					wipe(b)
+					return nil, err
+				}
+				b = utf8.AppendRune(b, r)
+			} else {
+				b = append(b, f.Separator...)
+			}
+		}
+		b = append(b, w...)
+		b = append(b, gaps[i+1]...)
+	}
+	b = append(b, pad...)
+	wipe(pad)
+	return b, nil
+}
+
+// Capitalize the first letter of a word and lower case the rest
+func title(w string) string {
+	if w == "" {
+		return w
+	}
+	r, n := utf8.DecodeRuneInString(w)
+	return string(unicode.ToUpper(r)) + strings.ToLower(w[n:])
+}
+
+// Check whether a word's upper and lower case forms differ, so RandomCase can change it
+func hasCase(w string) bool {
+	return strings.ToUpper(w) != strings.ToLower(w)
+}
+
+// Get a random character from a Charset
+func randRune(rand io.Reader, c Charset) (rune, error) {
+	i, err := randInt(rand, c.Len())
+	if err != nil {
+		return 0, err
+	}
+	return c.chars[i], nil
+}
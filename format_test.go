@@ -0,0 +1,170 @@
+package passgen
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// Get a generator with a dictionary of plain lower case words, so the format is easy to check
+func formatGenerator(t *testing.T, f Format) *PassphraseGenerator {
+	gen := &PassphraseGenerator{Format: f, words: []string{"correct", "horse", "battery", "staple", "tree", "apple"}, MinWordLength: 1, MaxWordLength: 10}
+	gen.filter()
+	return gen
+}
+
+func TestFormatSeparator(t *testing.T) {
+	gen := formatGenerator(t, Format{Separator: "-"})
+	p, err := gen.Passphrase(4)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if s := strings.Split(p, "-"); len(s) != 4 {
+		t.Errorf("Incorrect separators in passphrase: %q", p)
+	}
+	if gen.Entropy(4) != 4*math.Log2(float64(len(gen.dict))) {
+		t.Error("A fixed separator should not add entropy")
+	}
+}
+
+func TestFormatRandomSeparators(t *testing.T) {
+	seps := NewCharset(".-_")
+	gen := formatGenerator(t, Format{Separators: seps})
+	p, err := gen.Passphrase(4)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if !regexp.MustCompile(`^[a-z]+[.\-_][a-z]+[.\-_][a-z]+[.\-_][a-z]+$`).MatchString(p) {
+		t.Errorf("Incorrect separators in passphrase: %q", p)
+	}
+	expected := 4*math.Log2(float64(len(gen.dict))) + 3*math.Log2(3)
+	if !closeTo(gen.Entropy(4), expected) {
+		t.Errorf("Incorrect entropy. Expected: %f\t Actual: %f", expected, gen.Entropy(4))
+	}
+}
+
+func TestFormatCapitalization(t *testing.T) {
+	tests := []struct {
+		capitalization Capitalization
+		pattern        string
+	}{
+		{TitleCase, `^[A-Z][a-z]+ [A-Z][a-z]+ [A-Z][a-z]+$`},
+		{UpperCase, `^[A-Z]+ [A-Z]+ [A-Z]+$`},
+		{LowerCase, `^[a-z]+ [a-z]+ [a-z]+$`},
+		{RandomCase, `^([a-z]+|[A-Z]+) ([a-z]+|[A-Z]+) ([a-z]+|[A-Z]+)$`},
+		{CamelCase, `^[a-z]+[A-Z][a-z]+[A-Z][a-z]+$`},
+	}
+	for _, test := range tests {
+		f := Format{Separator: " ", Capitalization: test.capitalization}
+		if test.capitalization == CamelCase {
+			f.Separator = ""
+		}
+		gen := formatGenerator(t, f)
+		p, err := gen.Passphrase(3)
+		if err != nil {
+			t.Fatal("Error generating passphrase", err)
+		}
+		if !regexp.MustCompile(test.pattern).MatchString(p) {
+			t.Errorf("Incorrect capitalization %d: %q", test.capitalization, p)
+		}
+	}
+
+	gen := formatGenerator(t, Format{Separator: " ", Capitalization: RandomCase})
+	if expected := 3*math.Log2(float64(len(gen.dict))) + 3; !closeTo(gen.Entropy(3), expected) {
+		t.Errorf("Incorrect entropy. Expected: %f\t Actual: %f", expected, gen.Entropy(3))
+	}
+}
+
+// Words with no case come out the same with RandomCase, so they add no entropy
+func TestFormatRandomCaseCaseless(t *testing.T) {
+	format := Format{Separator: " ", Capitalization: RandomCase}
+	for _, test := range []struct {
+		opt   PassphraseOption
+		cased float64
+	}{
+		{WithLanguage("ja"), 0},
+		{WithLanguage("ko"), 0},
+		{WithDictionaryWords([]string{"apple", "りんご", "cherry", "사과"}), 0.5},
+		{WithDictionaryWords([]string{"apple", "banana", "cherry", "kiwi"}), 1},
+	} {
+		gen, err := NewPassphrase(test.opt, WithWordLength(1, 20), WithFormat(format))
+		if err != nil {
+			t.Fatal("Error creating passphrase generator", err)
+		}
+		expected := 4*math.Log2(float64(len(gen.dict))) + 4*test.cased
+		if !closeTo(gen.Entropy(4), expected) {
+			t.Errorf("Incorrect entropy for %d words. Expected: %f\t Actual: %f", len(gen.dict), expected, gen.Entropy(4))
+		}
+	}
+}
+
+func TestFormatExtras(t *testing.T) {
+	gen := formatGenerator(t, Format{Separator: " ", Digits: 2, Symbols: 1, SymbolChars: NewCharset("!?")})
+	p, err := gen.Passphrase(3)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if !regexp.MustCompile(`^[a-z]+ [a-z]+ [a-z]+[0-9]{2}[!?]$`).MatchString(p) {
+		t.Errorf("Incorrect extras in passphrase: %q", p)
+	}
+	expected := 3*math.Log2(float64(len(gen.dict))) + 2*math.Log2(10) + 1
+	if !closeTo(gen.Entropy(3), expected) {
+		t.Errorf("Incorrect entropy. Expected: %f\t Actual: %f", expected, gen.Entropy(3))
+	}
+
+	gen.Format.Placement = BetweenWords
+	for i := 0; i < 20; i++ {
+		p, err := gen.Passphrase(3)
+		if err != nil {
+			t.Fatal("Error generating passphrase", err)
+		}
+		if len(regexp.MustCompile(`[0-9]`).FindAllString(p, -1)) != 2 || len(regexp.MustCompile(`[!?]`).FindAllString(p, -1)) != 1 {
+			t.Errorf("Incorrect extras in passphrase: %q", p)
+		}
+		if len(strings.Split(p, " ")) != 3 {
+			t.Errorf("Extras should not replace separators: %q", p)
+		}
+	}
+}
+
+func TestFormatPadding(t *testing.T) {
+	gen := formatGenerator(t, Format{Separator: " ", Padding: 3, SymbolChars: NewCharset("*")})
+	p, err := gen.Passphrase(2)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if !regexp.MustCompile(`^\*\*\*[a-z]+ [a-z]+\*\*\*$`).MatchString(p) {
+		t.Errorf("Incorrect padding in passphrase: %q", p)
+	}
+}
+
+func TestFormatWordsForEntropy(t *testing.T) {
+	gen := formatGenerator(t, Format{Separator: " ", Digits: 3, Capitalization: RandomCase})
+	n, err := gen.WordsForEntropy(80)
+	if err != nil {
+		t.Fatal("Error calculating words", err)
+	}
+	if gen.Entropy(n) < 80 || gen.Entropy(n-1) >= 80 {
+		t.Errorf("Incorrect number of words: %d", n)
+	}
+}
+
+func TestFormatNegativeCounts(t *testing.T) {
+	for _, f := range []Format{{Digits: -3}, {Symbols: -1}, {Padding: -2}} {
+		gen := formatGenerator(t, f)
+		if _, err := gen.Passphrase(3); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("Expected ErrInvalidLength for %+v, got %v", f, err)
+		}
+		if _, err := gen.Bits(); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("Expected ErrInvalidLength for %+v, got %v", f, err)
+		}
+		if _, err := gen.WordsForEntropy(80); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("Expected ErrInvalidLength for %+v, got %v", f, err)
+		}
+		if _, err := NewPassphrase(WithFormat(f)); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("Expected ErrInvalidLength for %+v, got %v", f, err)
+		}
+	}
+}
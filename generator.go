@@ -0,0 +1,189 @@
+package passgen
+
+import (
+	"context"
+	"fmt"
+)
+
+// Generator is implemented by every kind of secret generator in the package, so they can be used interchangeably.
+// Each generator creates secrets of the size it was configured with, such as the password lengths set with WithLength
+// or the number of words set with WithWordCount
+type Generator interface {
+	// Generate a new secret. Returns ctx.Err() if the context is done before the secret is generated
+	Generate(ctx context.Context) (Secret, error)
+	// Bits returns the entropy, in bits, of each generated secret
+	Bits() (float64, error)
+	// Describe returns a short description of the secrets the generator creates, for people to read
+	Describe() string
+}
+
+var (
+	_ Generator = (*PasswordGenerator)(nil)
+	_ Generator = (*PassphraseGenerator)(nil)
+	_ Generator = (*RunePasswordGenerator)(nil)
+)
+
+// Generate a password with the lengths set by WithLength or SetLength, or 14 to 20 characters long if they weren't set
+func (p *PasswordGenerator) Generate(ctx context.Context) (Secret, error) {
+	if err := ctx.Err(); err != nil {
+		return Secret{}, err
+	}
+	min, max := p.length()
+	b, err := p.GeneratePasswordBytes(min, max)
+	if err != nil {
+		return Secret{}, err
+	}
+	defer wipe(b)
+	return NewSecret(b), nil
+}
+
+// Bits returns the entropy, in bits, of the passwords created by Generate
+func (p *PasswordGenerator) Bits() (float64, error) {
+	min, max := p.length()
+	return p.Entropy(min, max)
+}
+
+// CollisionBits returns the collision entropy, in bits, of the passwords created by Generate. See CollisionEntropy
+func (p *PasswordGenerator) CollisionBits() (float64, error) {
+	min, max := p.length()
+	return p.CollisionEntropy(min, max)
+}
+
+// Describe the passwords created by Generate, such as "14 to 20 character password from 95 characters"
+func (p *PasswordGenerator) Describe() string {
+	min, max := p.length()
+	length := fmt.Sprintf("%d to %d", min, max)
+	if min == max {
+		length = fmt.Sprint(min)
+	}
+	d := fmt.Sprintf("%s character password from %d characters", length, p.Charset().Len())
+	if p.policy != nil {
+		d += fmt.Sprintf(" with %d composition rules", len(p.policy.Requirements))
+	}
+	return d
+}
+
+// Set the lengths of the passwords created by Password and Generate.
+// Returns a PolicyError if the generator's Policy can't be satisfied by passwords of those lengths
+func (p *PasswordGenerator) SetLength(min, max int) error {
+	if err := checkLength(min, max); err != nil {
+		return err
+	}
+	if p.policy != nil {
+		if _, _, err := p.policy.checkLength(p.Charset(), min, max); err != nil {
+			return err
+		}
+	}
+	p.minLength, p.maxLength = min, max
+	return nil
+}
+
+// Generate a password with the lengths, in characters, set by SetLength, or 14 to 20 characters long if they weren't set
+func (p *RunePasswordGenerator) Generate(ctx context.Context) (Secret, error) {
+	if err := ctx.Err(); err != nil {
+		return Secret{}, err
+	}
+	min, max := p.length()
+	b, err := p.GeneratePasswordBytes(min, max)
+	if err != nil {
+		return Secret{}, err
+	}
+	defer wipe(b)
+	return NewSecret(b), nil
+}
+
+// Bits returns the entropy, in bits, of the passwords created by Generate
+func (p *RunePasswordGenerator) Bits() (float64, error) {
+	min, max := p.length()
+	return p.Entropy(min, max)
+}
+
+// CollisionBits returns the collision entropy, in bits, of the passwords created by Generate. See CollisionEntropy
+func (p *RunePasswordGenerator) CollisionBits() (float64, error) {
+	min, max := p.length()
+	return p.CollisionEntropy(min, max)
+}
+
+// Describe the passwords created by Generate, such as "14 to 20 character password from 1000 Unicode characters"
+func (p *RunePasswordGenerator) Describe() string {
+	min, max := p.length()
+	length := fmt.Sprintf("%d to %d", min, max)
+	if min == max {
+		length = fmt.Sprint(min)
+	}
+	return fmt.Sprintf("%s character password from %d Unicode characters", length, len(p.chars))
+}
+
+// Set the lengths, in characters, of the passwords created by Generate
+func (p *RunePasswordGenerator) SetLength(min, max int) error {
+	if err := checkLength(min, max); err != nil {
+		return err
+	}
+	p.minLength, p.maxLength = min, max
+	return nil
+}
+
+// Get the lengths of the passwords created by Generate
+func (p *RunePasswordGenerator) length() (int, int) {
+	if p.maxLength == 0 {
+		return DefaultMinLength, DefaultMaxLength
+	}
+	return p.minLength, p.maxLength
+}
+
+// Generate a passphrase with the number of words set by WithWordCount or SetWordCount, or 4 words if it wasn't set
+func (p *PassphraseGenerator) Generate(ctx context.Context) (Secret, error) {
+	if err := ctx.Err(); err != nil {
+		return Secret{}, err
+	}
+	b, err := p.PassphraseBytes(p.wordCount())
+	if err != nil {
+		return Secret{}, err
+	}
+	defer wipe(b)
+	return NewSecret(b), nil
+}
+
+// Bits returns the entropy, in bits, of the passphrases created by Generate
+func (p *PassphraseGenerator) Bits() (float64, error) {
+	if len(p.dict) == 0 {
+		return 0, ErrEmptyDictionary
+	}
+	if err := p.Format.check(); err != nil {
+		return 0, err
+	}
+	return p.Entropy(p.wordCount()), nil
+}
+
+// CollisionBits returns the collision entropy, in bits, of the passphrases created by Generate. See CollisionEntropy
+func (p *PassphraseGenerator) CollisionBits() (float64, error) {
+	if len(p.dict) == 0 {
+		return 0, ErrEmptyDictionary
+	}
+	if err := p.Format.check(); err != nil {
+		return 0, err
+	}
+	return p.CollisionEntropy(p.wordCount()), nil
+}
+
+// Describe the passphrases created by Generate, such as "4 word passphrase from internal (15000 words)"
+func (p *PassphraseGenerator) Describe() string {
+	return fmt.Sprintf("%d word passphrase from %s (%d words)", p.wordCount(), p.DictionaryFile, len(p.dict))
+}
+
+// Set the number of words in the passphrases created by Generate
+func (p *PassphraseGenerator) SetWordCount(n int) error {
+	if n < 1 {
+		return fmt.Errorf("%w: number of words must be positive", ErrInvalidLength)
+	}
+	p.numWords = n
+	return nil
+}
+
+// Get the number of words in the passphrases created by Generate
+func (p *PassphraseGenerator) wordCount() int {
+	if p.numWords == 0 {
+		return DefaultWordCount
+	}
+	return p.numWords
+}
@@ -0,0 +1,26 @@
+package passgen
+
+import (
+	"context"
+	"fmt"
+)
+
+func ExampleGenerator() {
+	// Passwords and passphrases can be generated the same way through the Generator interface
+	password, err := NewPassword(WithCharset(AlphaNumeric), WithLength(16, 16))
+	if err != nil {
+		// Handle error
+	}
+	passphrase, err := NewPassphrase(WithWordList("eff-large"), WithWordCount(6))
+	if err != nil {
+		// Handle error
+	}
+	for _, gen := range []Generator{password, passphrase} {
+		s, err := gen.Generate(context.Background())
+		if err != nil {
+			// Handle error
+		}
+		bits, _ := gen.Bits()
+		fmt.Printf("%s (%.0f bits): %s\n", gen.Describe(), bits, s.Value())
+	}
+}
@@ -0,0 +1,130 @@
+package passgen
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestGenerators(t *testing.T) {
+	password, err := NewPassword(WithCharset(Digits), WithLength(6, 6))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	passphrase, err := NewPassphrase(WithDictionaryWords([]string{"apple", "banana", "cherry", "kiwi"}), WithWordCount(3))
+	if err != nil {
+		t.Fatal("Error creating passphrase generator", err)
+	}
+
+	runes, err := NewRunePasswordGenerator(CharRange('α', 'ω'), NFC)
+	if err != nil {
+		t.Fatal("Error creating rune password generator", err)
+	}
+	if err := runes.SetLength(8, 8); err != nil {
+		t.Fatal("Error setting length", err)
+	}
+
+	tests := []struct {
+		gen      Generator
+		bits     float64
+		describe string
+		check    func(s string) bool
+	}{
+		{password, 6 * math.Log2(10), "6 character password from 10 characters", func(s string) bool {
+			return len(s) == 6 && strings.Trim(s, Digits.String()) == ""
+		}},
+		{passphrase, 3 * 2, "3 word passphrase from words (4 words)", func(s string) bool {
+			return len(strings.Fields(s)) == 3
+		}},
+		{runes, 8 * math.Log2(25), "8 character password from 25 Unicode characters", func(s string) bool {
+			return utf8.RuneCountInString(s) == 8 && strings.Trim(s, CharRange('α', 'ω').String()) == ""
+		}},
+	}
+	for _, test := range tests {
+		s, err := test.gen.Generate(context.Background())
+		if err != nil {
+			t.Fatal("Error generating secret", err)
+		}
+		if !test.check(s.Value()) || s.Len() != len(s.Bytes()) {
+			t.Errorf("Incorrect secret from %s: %q", test.gen.Describe(), s.Value())
+		}
+		bits, err := test.gen.Bits()
+		if err != nil {
+			t.Fatal("Error calculating entropy", err)
+		}
+		if !closeTo(bits, test.bits) {
+			t.Errorf("Incorrect entropy for %s. Expected: %f\t Actual: %f", test.gen.Describe(), test.bits, bits)
+		}
+		if test.gen.Describe() != test.describe {
+			t.Errorf("Incorrect description. Expected: %q\t Actual: %q", test.describe, test.gen.Describe())
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := test.gen.Generate(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled from %s, got %v", test.gen.Describe(), err)
+		}
+	}
+}
+
+func TestGeneratorDefaults(t *testing.T) {
+	s, err := GetSecurePasswordGenerator().Generate(context.Background())
+	if err != nil {
+		t.Fatal("Error generating password", err)
+	}
+	if s.Len() < DefaultMinLength || s.Len() > DefaultMaxLength {
+		t.Errorf("Incorrect sized password returned: %q", s.Value())
+	}
+
+	gen, err := GetXKCDPassphraseGenerator()
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	s, err = gen.Generate(context.Background())
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if len(strings.Fields(s.Value())) != DefaultWordCount {
+		t.Errorf("Incorrect passphrase: %q", s.Value())
+	}
+}
+
+func TestSetLength(t *testing.T) {
+	gen := GetAlphaLowerPasswordGenerator()
+	if err := gen.SetLength(8, 4); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength, got %v", err)
+	}
+	if err := gen.SetPolicy(&Policy{Requirements: []Requirement{{Charset: NewCharset("xyz"), Min: 5}}}); err != nil {
+		t.Fatal("Error setting policy", err)
+	}
+	if err := gen.SetLength(2, 4); !errors.Is(err, ErrPolicyUnsatisfiable) {
+		t.Errorf("Expected ErrPolicyUnsatisfiable, got %v", err)
+	}
+	if err := gen.SetLength(5, 5); err != nil {
+		t.Fatal("Error setting length", err)
+	}
+	if s, err := gen.Password(); err != nil || strings.Trim(s, "xyz") != "" {
+		t.Errorf("Incorrect password %q: %v", s, err)
+	}
+
+	phraseGen, err := GetXKCDPassphraseGenerator()
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	if err := phraseGen.SetWordCount(0); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength, got %v", err)
+	}
+	runeGen, err := NewRunePasswordGenerator(CharRange('a', 'e'), NoNormalization)
+	if err != nil {
+		t.Fatal("Error creating rune password generator", err)
+	}
+	if err := runeGen.SetLength(8, 4); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength, got %v", err)
+	}
+	if s, err := runeGen.Generate(context.Background()); err != nil || s.Len() < DefaultMinLength || s.Len() > DefaultMaxLength {
+		t.Errorf("Incorrect default password %q: %v", s.Value(), err)
+	}
+}
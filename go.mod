@@ -0,0 +1,20 @@
+module github.com/justinjudd/passgen
+
+go 1.25.0
+
+// The cobra fork the command imports isn't published as a module, so build it with upstream cobra
+replace github.com/justinjudd/cobra => github.com/spf13/cobra v1.9.1
+
+require (
+	github.com/justinjudd/cobra v0.0.0
+	github.com/klauspost/compress v1.18.0
+	github.com/rivo/uniseg v0.4.7
+	golang.org/x/crypto v0.54.0
+	golang.org/x/text v0.40.0
+)
+
+require (
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/spf13/pflag v1.0.6 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+)
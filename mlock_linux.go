@@ -0,0 +1,69 @@
+package passgen
+
+import (
+	"os"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// Small Secrets share pages of memory, and pages are locked and unlocked as a whole,
+// so count how many Secrets are using each locked page and only unlock a page once none are
+var lockedPages = struct {
+	sync.Mutex
+	count map[uintptr]int
+}{count: make(map[uintptr]int)}
+
+var pageSize = uintptr(os.Getpagesize())
+
+// Get the start of the first page and the end of the last page holding b
+func pageBounds(b []byte) (uintptr, uintptr) {
+	start := uintptr(unsafe.Pointer(&b[0]))
+	end := start + uintptr(len(b))
+	return start &^ (pageSize - 1), (end + pageSize - 1) &^ (pageSize - 1)
+}
+
+// Lock memory so it can't be written to swap. Fails if the process would go over its RLIMIT_MEMLOCK limit
+func mlock(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	start, end := pageBounds(b)
+	lockedPages.Lock()
+	defer lockedPages.Unlock()
+	// Secrets are often created next to each other, so skip the system call if another Secret has already locked the pages
+	locked := true
+	for page := start; page < end && locked; page += pageSize {
+		locked = lockedPages.count[page] > 0
+	}
+	if !locked {
+		if _, _, errno := syscall.Syscall(syscall.SYS_MLOCK, start, end-start, 0); errno != 0 {
+			return errno
+		}
+	}
+	for page := start; page < end; page += pageSize {
+		lockedPages.count[page]++
+	}
+	return nil
+}
+
+// Unlock memory locked by mlock, leaving pages that other Secrets still use locked
+func munlock(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	start, end := pageBounds(b)
+	lockedPages.Lock()
+	defer lockedPages.Unlock()
+	for page := start; page < end; page += pageSize {
+		lockedPages.count[page]--
+		if lockedPages.count[page] > 0 {
+			continue
+		}
+		delete(lockedPages.count, page)
+		if _, _, errno := syscall.Syscall(syscall.SYS_MUNLOCK, page, pageSize, 0); errno != 0 {
+			return errno
+		}
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+//go:build !linux
+
+package passgen
+
+import "errors"
+
+// Memory locking is only supported on Linux
+func mlock(b []byte) error {
+	return errors.New("mlock is not supported on this platform")
+}
+
+func munlock(b []byte) error {
+	return nil
+}
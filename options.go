@@ -0,0 +1,433 @@
+package passgen
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+)
+
+// Password lengths used by PasswordGenerator.Password and Generate unless WithLength is given
+const (
+	DefaultMinLength = 14
+	DefaultMaxLength = 20
+)
+
+// Number of words in the passphrases created by PassphraseGenerator.Generate unless WithWordCount is given
+const DefaultWordCount = 4
+
+// PasswordOption configures a PasswordGenerator created by NewPassword
+type PasswordOption interface {
+	applyPassword(c *passwordConfig) error
+}
+
+// PassphraseOption configures a PassphraseGenerator created by NewPassphrase
+type PassphraseOption interface {
+	applyPassphrase(c *passphraseConfig) error
+}
+
+// Option configures both PasswordGenerators and PassphraseGenerators, so it can be given to NewPassword or NewPassphrase
+type Option interface {
+	PasswordOption
+	PassphraseOption
+}
+
+type passwordOption func(c *passwordConfig) error
+
+func (o passwordOption) applyPassword(c *passwordConfig) error {
+	return o(c)
+}
+
+type passphraseOption func(c *passphraseConfig) error
+
+func (o passphraseOption) applyPassphrase(c *passphraseConfig) error {
+	return o(c)
+}
+
+// Settings collected from PasswordOptions
+type passwordConfig struct {
+	charset   Charset
+	start     byte
+	size      int
+	useRange  bool
+	fn        func(i uint32) byte
+	ambiguous []Charset
+	min, max  int
+	policy    *Policy
+	rand      io.Reader
+}
+
+// Settings collected from PassphraseOptions
+type passphraseConfig struct {
+	dictFile string
+	load     func(p *PassphraseGenerator) error
+	min, max int
+	unit     LengthUnit
+	numWords int
+	format   Format
+	filters  []WordFilter
+	rand     io.Reader
+}
+
+// Create a new Password Generator configured by the given options.
+// Without options, it generates passwords of 14 to 20 printable ASCII characters, like GetSecurePasswordGenerator.
+// Every option is checked before the generator is returned, so a generator from NewPassword is ready to use
+func NewPassword(opts ...PasswordOption) (*PasswordGenerator, error) {
+	c := passwordConfig{charset: Printable, min: DefaultMinLength, max: DefaultMaxLength}
+	for _, o := range opts {
+		if err := o.applyPassword(&c); err != nil {
+			return nil, err
+		}
+	}
+
+	p := &PasswordGenerator{minLength: c.min, maxLength: c.max}
+	var alphabet []byte
+	if c.useRange {
+		var err error
+		if alphabet, err = rangeAlphabet(c.start, c.size, c.fn); err != nil {
+			return nil, err
+		}
+		alphabet = removeAmbiguous(alphabet, c.ambiguous)
+		if len(alphabet) == 0 {
+			return nil, fmt.Errorf("%w: every character is ambiguous", ErrInvalidCharset)
+		}
+	} else {
+		chars := c.charset.Subtract(c.ambiguous...)
+		if chars.Len() == 0 {
+			return nil, fmt.Errorf("%w: must contain at least one character", ErrInvalidCharset)
+		}
+		if !chars.isASCII() {
+			return nil, fmt.Errorf("%w: must only contain ASCII characters", ErrInvalidCharset)
+		}
+		alphabet = []byte(chars.String())
+	}
+	p.setAlphabet(alphabet)
+
+	if c.policy != nil {
+		if err := p.SetPolicy(c.policy); err != nil {
+			return nil, err
+		}
+		if _, _, err := p.policy.checkLength(p.Charset(), c.min, c.max); err != nil {
+			return nil, err
+		}
+	}
+	if c.rand != nil {
+		if err := p.SetEntropySource(c.rand); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Draw password characters from the given Charset. Only single byte (ASCII) characters are supported
+func WithCharset(chars Charset) PasswordOption {
+	return passwordOption(func(c *passwordConfig) error {
+		c.charset, c.useRange = chars, false
+		return nil
+	})
+}
+
+// Draw password characters from the size characters starting at start, like NewPasswordGenerator
+func WithCharRange(start byte, size int) PasswordOption {
+	return passwordOption(func(c *passwordConfig) error {
+		if size < 1 || size > 256 {
+			return fmt.Errorf("%w: size must be from 1 to 256, not %d", ErrInvalidCharset, size)
+		}
+		c.start, c.size, c.fn, c.useRange = start, size, nil, true
+		return nil
+	})
+}
+
+// Draw password characters from the size characters that f maps the numbers 0 to size-1 to, such as every other digit.
+// f must map each number to a different character, and is only called while the generator is created
+func WithCharFunc(size int, f func(i uint32) byte) PasswordOption {
+	return passwordOption(func(c *passwordConfig) error {
+		if size < 1 || size > 256 {
+			return fmt.Errorf("%w: size must be from 1 to 256, not %d", ErrInvalidCharset, size)
+		}
+		if f == nil {
+			return errors.New("Character function must not be nil")
+		}
+		c.size, c.fn, c.useRange = size, f, true
+		return nil
+	})
+}
+
+// Leave out characters that are easily mistaken for one another. The characters in Ambiguous are left out unless others are given.
+// Applies to alphabets set with WithCharset, WithCharRange or WithCharFunc
+func WithUnambiguous(ambiguous ...Charset) PasswordOption {
+	if len(ambiguous) == 0 {
+		ambiguous = []Charset{Ambiguous}
+	}
+	return passwordOption(func(c *passwordConfig) error {
+		c.ambiguous = append(c.ambiguous, ambiguous...)
+		return nil
+	})
+}
+
+// Remove the characters in any of the ambiguous sets from a range alphabet, keeping the rest in order
+func removeAmbiguous(alphabet []byte, ambiguous []Charset) []byte {
+	var out []byte
+next:
+	for _, b := range alphabet {
+		for _, c := range ambiguous {
+			if c.Contains(rune(b)) {
+				continue next
+			}
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+// Set the lengths of the passwords created by Password and Generate
+func WithLength(min, max int) PasswordOption {
+	return passwordOption(func(c *passwordConfig) error {
+		if err := checkLength(min, max); err != nil {
+			return err
+		}
+		c.min, c.max = min, max
+		return nil
+	})
+}
+
+// Attach a Policy to the generator. NewPassword returns a PolicyError if it can't be satisfied by passwords of the lengths set by WithLength
+func WithPolicy(pol *Policy) PasswordOption {
+	return passwordOption(func(c *passwordConfig) error {
+		c.policy = pol
+		return nil
+	})
+}
+
+// Create a new Passphrase Generator configured by the given options.
+// Without options, it uses every word of the internal dictionary, separated by spaces.
+// Every option is checked and the dictionary loaded before the generator is returned.
+// If no words meet the word length requirements, the generator is returned along with ErrEmptyDictionary
+func NewPassphrase(opts ...PassphraseOption) (*PassphraseGenerator, error) {
+	c := passphraseConfig{max: math.MaxInt32, format: DefaultFormat}
+	WithDictionary("internal").applyPassphrase(&c)
+	for _, o := range opts {
+		if err := o.applyPassphrase(&c); err != nil {
+			return nil, err
+		}
+	}
+
+	p := &PassphraseGenerator{DictionaryFile: c.dictFile, MinWordLength: c.min, MaxWordLength: c.max, LengthUnit: c.unit, Format: c.format, numWords: c.numWords}
+	if err := c.load(p); err != nil {
+		return nil, err
+	}
+	if len(c.filters) > 0 {
+		p.Clean(c.filters...)
+	} else {
+		p.filter()
+	}
+	if c.rand != nil {
+		if err := p.SetEntropySource(c.rand); err != nil {
+			return nil, err
+		}
+	}
+	if len(p.dict) == 0 {
+		return p, ErrEmptyDictionary
+	}
+	return p, nil
+}
+
+// Choose words from a dictionary file with one word per line, or from the internal list of words if dictFile is "internal".
+// Gzip and zstd compressed files are decompressed automatically. Use WithWordList for the other built-in lists
+func WithDictionary(dictFile string) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		if dictFile == "internal" {
+			list, _ := LookupWordList(dictFile)
+			c.load = func(p *PassphraseGenerator) error {
+				return p.loadMemoryDict(list)
+			}
+		} else {
+			c.load = (*PassphraseGenerator).loadDict
+		}
+		c.dictFile = dictFile
+		return nil
+	})
+}
+
+// Choose words from the built-in word list with the given name or alias, such as "eff-large". See WordLists for the lists.
+// Numbered Diceware lists can also be used with PassphraseFromRolls.
+// Returns an error matching ErrUnknownWordList if there is no built-in list with the name
+func WithWordList(name string) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		list, ok := LookupWordList(name)
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownWordList, name)
+		}
+		c.dictFile = list.Name
+		c.load = func(p *PassphraseGenerator) error {
+			if list.Dice == 0 {
+				return p.loadMemoryDict(list)
+			}
+			l, err := list.dicewareList()
+			if err != nil {
+				return err
+			}
+			p.words, p.diceware = l.words, l
+			return nil
+		}
+		return nil
+	})
+}
+
+// Choose words from a dictionary read from r, with one word per line.
+// Gzip and zstd compressed dictionaries are decompressed automatically
+func WithDictionaryReader(r io.Reader) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		c.dictFile = "reader"
+		c.load = func(p *PassphraseGenerator) error {
+			return p.readDict(r, "reader")
+		}
+		return nil
+	})
+}
+
+// Choose words from a dictionary file in a filesystem, such as an embed.FS, with one word per line.
+// Gzip and zstd compressed dictionaries are decompressed automatically
+func WithDictionaryFS(fsys fs.FS, path string) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		c.dictFile = path
+		c.load = func(p *PassphraseGenerator) error {
+			file, err := fsys.Open(path)
+			if err != nil {
+				return &DictionaryError{Path: path, Err: err}
+			}
+			defer file.Close()
+			return p.readDict(file, path)
+		}
+		return nil
+	})
+}
+
+// Choose words from a list of words
+func WithDictionaryWords(words []string) PassphraseOption {
+	words = append([]string{}, words...)
+	return passphraseOption(func(c *passphraseConfig) error {
+		c.dictFile = "words"
+		c.load = func(p *PassphraseGenerator) error {
+			p.words = words
+			return nil
+		}
+		return nil
+	})
+}
+
+// Choose words from a numbered Diceware list file, so passphrases can also be created from physical dice rolls with PassphraseFromRolls.
+// The built-in Diceware lists, such as "eff-large", are chosen with WithWordList.
+// Limiting the word lengths with WithWordLength or cleaning the list with WithCleanup stops the rolls lining up with the words
+func WithDiceware(dictFile string) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		c.dictFile = dictFile
+		c.load = func(p *PassphraseGenerator) error {
+			l, err := LoadDicewareList(dictFile)
+			if err != nil {
+				return err
+			}
+			p.words, p.diceware = l.words, l
+			return nil
+		}
+		return nil
+	})
+}
+
+// Choose words from the built-in word list in the language that best matches a BCP 47 locale tag, such as "es-ES" or "ja".
+// Returns an error matching ErrUnsupportedLanguage if there is no list in the locale's language
+func WithLanguage(locale string) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		list, err := LookupLanguage(locale)
+		if err != nil {
+			return err
+		}
+		return WithWordList(list.Name).applyPassphrase(c)
+	})
+}
+
+// Only use words between min and max characters long
+func WithWordLength(min, max int) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		if err := checkLength(min, max); err != nil {
+			return err
+		}
+		c.min, c.max = min, max
+		return nil
+	})
+}
+
+// Set the number of words in the passphrases created by Generate
+func WithWordCount(n int) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		if n < 1 {
+			return fmt.Errorf("%w: number of words must be positive", ErrInvalidLength)
+		}
+		c.numWords = n
+		return nil
+	})
+}
+
+// Set how word lengths are counted. Characters (runes) by default
+func WithLengthUnit(unit LengthUnit) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		c.unit = unit
+		return nil
+	})
+}
+
+// Put the chosen words together with the given Format. Returns an error matching ErrInvalidLength if its Digits, Symbols or Padding are negative
+func WithFormat(f Format) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		if err := f.check(); err != nil {
+			return err
+		}
+		c.format = f
+		return nil
+	})
+}
+
+// Put sep between each pair of words
+func WithSeparator(sep string) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		c.format.Separator = sep
+		c.format.Separators = Charset{}
+		return nil
+	})
+}
+
+// Run the dictionary through a cleanup pipeline, such as DefaultCleanup(), before words are chosen by length
+func WithCleanup(filters ...WordFilter) PassphraseOption {
+	return passphraseOption(func(c *passphraseConfig) error {
+		c.filters = append(c.filters, filters...)
+		return nil
+	})
+}
+
+// An option that applies to both kinds of generator
+type sharedOption struct {
+	passwordOption
+	passphraseOption
+}
+
+// Read random data from r instead of crypto/rand.Reader. See the package documentation on entropy sources for which readers are safe.
+// Readers known not to be cryptographically secure are refused with ErrInsecureEntropySource unless wrapped with InsecureEntropySource
+func WithEntropySource(r io.Reader) Option {
+	return sharedOption{
+		passwordOption(func(c *passwordConfig) error {
+			if err := checkEntropySource(r); err != nil {
+				return err
+			}
+			c.rand = r
+			return nil
+		}),
+		passphraseOption(func(c *passphraseConfig) error {
+			if err := checkEntropySource(r); err != nil {
+				return err
+			}
+			c.rand = r
+			return nil
+		}),
+	}
+}
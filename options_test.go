@@ -0,0 +1,162 @@
+package passgen
+
+import (
+	"bytes"
+	"errors"
+	mathrand "math/rand"
+	"strings"
+	"testing"
+)
+
+func TestNewPasswordDefaults(t *testing.T) {
+	gen, err := NewPassword()
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	p, err := gen.Password()
+	if err != nil {
+		t.Fatal("Error generating password", err)
+	}
+	if len(p) < DefaultMinLength || len(p) > DefaultMaxLength {
+		t.Errorf("Incorrect sized password returned: %q", p)
+	}
+	if gen.Charset().String() != Printable.String() {
+		t.Errorf("Incorrect alphabet: %q", gen.Charset().String())
+	}
+}
+
+func TestNewPasswordOptions(t *testing.T) {
+	gen, err := NewPassword(WithCharset(Digits), WithUnambiguous(), WithLength(6, 6))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if gen.Charset().String() != "34679" {
+		t.Errorf("Incorrect alphabet: %q", gen.Charset().String())
+	}
+	p, err := gen.Password()
+	if err != nil {
+		t.Fatal("Error generating password", err)
+	}
+	if len(p) != 6 {
+		t.Errorf("Incorrect sized password returned: %q", p)
+	}
+
+	// Ambiguous characters are left out of character ranges too
+	gen, err = NewPassword(WithCharRange('0', 10), WithUnambiguous())
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if gen.Charset().String() != "34679" {
+		t.Errorf("Incorrect alphabet: %q", gen.Charset().String())
+	}
+	gen, err = NewPassword(WithUnambiguous(NewCharset("4")), WithCharFunc(5, func(i uint32) byte {
+		return '0' + byte(i*2)
+	}))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if gen.Charset().String() != "0268" {
+		t.Errorf("Incorrect alphabet: %q", gen.Charset().String())
+	}
+
+	gen, err = NewPassword(WithCharRange('a', 5), WithPolicy(&Policy{Requirements: []Requirement{{Charset: NewCharset("e"), Min: 2}}}))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	p, err = gen.Password()
+	if err != nil {
+		t.Fatal("Error generating password", err)
+	}
+	if strings.Count(p, "e") < 2 || strings.Trim(p, "abcde") != "" {
+		t.Errorf("Password doesn't meet policy: %q", p)
+	}
+}
+
+func TestNewPasswordValidation(t *testing.T) {
+	tests := []struct {
+		opts []PasswordOption
+		err  error
+	}{
+		{[]PasswordOption{WithCharset(NewCharset("äö"))}, ErrInvalidCharset},
+		{[]PasswordOption{WithCharset(Charset{})}, ErrInvalidCharset},
+		{[]PasswordOption{WithCharset(Digits), WithUnambiguous(Digits)}, ErrInvalidCharset},
+		{[]PasswordOption{WithCharRange('a', 0)}, ErrInvalidCharset},
+		{[]PasswordOption{WithCharRange('0', 10), WithUnambiguous(Digits)}, ErrInvalidCharset},
+		{[]PasswordOption{WithLength(5, 3)}, ErrInvalidLength},
+		{[]PasswordOption{WithLength(2, 4), WithPolicy(&Policy{Requirements: []Requirement{{Charset: Digits, Min: 5}}})}, ErrPolicyUnsatisfiable},
+		{[]PasswordOption{WithPolicy(&Policy{Requirements: []Requirement{{Charset: NewCharset("é"), Min: 1}}})}, ErrPolicyUnsatisfiable},
+		{[]PasswordOption{WithEntropySource(bytes.NewReader(nil))}, ErrInsecureEntropySource},
+	}
+	for i, test := range tests {
+		if _, err := NewPassword(test.opts...); !errors.Is(err, test.err) {
+			t.Errorf("Test %d: expected %v, got %v", i, test.err, err)
+		}
+	}
+}
+
+func TestNewPassphraseOptions(t *testing.T) {
+	gen, err := NewPassphrase(
+		WithDictionaryWords([]string{" apple", "banana", "Banana", "fig", "cherry"}),
+		WithCleanup(DefaultCleanup()...),
+		WithWordLength(5, 6),
+		WithSeparator("-"),
+		WithEntropySource(InsecureEntropySource(mathrand.New(mathrand.NewSource(1)))),
+	)
+	if err != nil {
+		t.Fatal("Error creating passphrase generator", err)
+	}
+	checkDict(t, gen, "apple", "banana", "cherry")
+	p, err := gen.Passphrase(3)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if len(strings.Split(p, "-")) != 3 {
+		t.Errorf("Incorrect passphrase: %q", p)
+	}
+
+	gen, err = NewPassphrase(WithWordList("eff-short-2"))
+	if err != nil {
+		t.Fatal("Error creating passphrase generator", err)
+	}
+	if gen.DicewareList() == nil || len(gen.dict) != 1296 {
+		t.Error("Expected every word of the Diceware list to be kept")
+	}
+
+	gen, err = NewPassphrase(WithLanguage("it"), WithLengthUnit(Bytes), WithWordLength(1, 4))
+	if err != nil {
+		t.Fatal("Error creating passphrase generator", err)
+	}
+	if gen.DictionaryFile != "bip39-it" {
+		t.Errorf("Incorrect dictionary: %s", gen.DictionaryFile)
+	}
+}
+
+func TestNewPassphraseDefaults(t *testing.T) {
+	gen, err := NewPassphrase()
+	if err != nil {
+		t.Fatal("Error creating passphrase generator", err)
+	}
+	internal, _ := LookupWordList("internal")
+	if len(gen.dict) != internal.Size {
+		t.Errorf("Expected every word of the internal dictionary, got %d", len(gen.dict))
+	}
+}
+
+func TestNewPassphraseValidation(t *testing.T) {
+	tests := []struct {
+		opts []PassphraseOption
+		err  error
+	}{
+		{[]PassphraseOption{WithWordLength(3, 1)}, ErrInvalidLength},
+		{[]PassphraseOption{WithLanguage("zh")}, ErrUnsupportedLanguage},
+		{[]PassphraseOption{WithDictionary("path/to/missing/dictionary")}, ErrDictionary},
+		{[]PassphraseOption{WithWordList("path/to/dictionary")}, ErrUnknownWordList},
+		{[]PassphraseOption{WithWordLength(40, 50)}, ErrEmptyDictionary},
+		{[]PassphraseOption{WithEntropySource(nil)}, ErrInsecureEntropySource},
+	}
+	for i, test := range tests {
+		if _, err := NewPassphrase(test.opts...); !errors.Is(err, test.err) {
+			t.Errorf("Test %d: expected %v, got %v", i, test.err, err)
+		}
+	}
+}
@@ -1,7 +1,13 @@
 package main
 
 import (
+	"bufio"
+	"context"
 	"fmt"
+	"math"
+	"os"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/justinjudd/cobra"
 	"github.com/justinjudd/passgen"
@@ -17,8 +23,51 @@ var (
 	phraseMaxFlag int
 	typeFlag      string
 	dictFlag      string
+
+	unambiguousFlag bool
+	entropyFlag     bool
+	bitsFlag        float64
+
+	separatorFlag   string
+	separatorsFlag  string
+	caseFlag        string
+	digitsFlag      int
+	symbolsFlag     int
+	symbolCharsFlag string
+	betweenFlag     bool
+	paddingFlag     int
+
+	dicewareFlag bool
+	diceFlag     bool
+	listFlag     string
+	langFlag     string
+
+	outputFlag  string
+	workersFlag int
+	uniqueFlag  bool
 )
 
+// Batches larger than this remember their secrets in a Bloom filter for --unique, rather than keeping a hash of every one
+const bloomThreshold = 10000000
+
+// Where generated secrets are written, set up by openOutput
+var output *bufio.Writer
+
+// Capitalization styles that can be chosen with --case
+var capitalizations = map[string]passgen.Capitalization{
+	"none":   passgen.NoCapitalization,
+	"title":  passgen.TitleCase,
+	"upper":  passgen.UpperCase,
+	"lower":  passgen.LowerCase,
+	"random": passgen.RandomCase,
+	"camel":  passgen.CamelCase,
+}
+
+// Well known Diceware lists that aren't built in, and where to download them
+var externalLists = map[string]string{
+	"eff-short-1": "https://www.eff.org/files/2016/09/08/eff_short_wordlist_1.txt",
+}
+
 func main() {
 
 	var rootCmd = &cobra.Command{
@@ -31,28 +80,36 @@ func main() {
 		Short: "password allows for a password to be generated.",
 		Long:  "password allows you to create secure passwords.",
 		Run: func(cmd *cobra.Command, args []string) {
-			var gen *passgen.PasswordGenerator
-			switch typeFlag {
-
-			case "secure", "s":
-				gen = passgen.GetSecurePasswordGenerator()
-			case "numeric", "n":
-				gen = passgen.GetNumericPasswordGenerator()
-			case "alphanumeric", "a":
-				gen = passgen.GetAlphaNumericPasswordGenerator()
-			default:
-				println("Unknown password type")
+			g, err := passgen.NewGenerator(typeFlag)
+			if err != nil {
+				fail(err)
+			}
+			gen, ok := g.(*passgen.PasswordGenerator)
+			if !ok {
+				// Other kinds of generator, such as passphrases, are generated as their profile describes
+				if unambiguousFlag || bitsFlag > 0 {
+					fail("--unambiguous and --bits only work with password profiles, not", typeFlag)
+				}
+				generate(g)
 				return
-
 			}
-			for i := 0; i < numFlag; i++ {
-				p, err := gen.GeneratePassword(minFlag, maxFlag)
+			if unambiguousFlag {
+				gen, err = gen.Unambiguous()
 				if err != nil {
-					fmt.Println("Error generating password:", err)
-					return
+					fail("Unable to create unambiguous password generator:", err)
 				}
-				fmt.Println(p)
 			}
+			if bitsFlag > 0 {
+				length, err := gen.LengthForEntropy(bitsFlag)
+				if err != nil {
+					fail("Error generating password:", err)
+				}
+				minFlag, maxFlag = length, length
+			}
+			if err := gen.SetLength(minFlag, maxFlag); err != nil {
+				fail("Error generating password:", err)
+			}
+			generate(gen)
 
 		},
 	}
@@ -61,36 +118,281 @@ func main() {
 		Short: "passphrase allows for a passphrase to be generated.",
 		Long:  "passphrase allows you to create secure passphrases.",
 		Run: func(cmd *cobra.Command, args []string) {
-			gen, err := passgen.NewPassphraseGenerator(dictFlag, phraseMinFlag, phraseMaxFlag)
+			if listFlag != "" {
+				if _, ok := passgen.LookupWordList(listFlag); !ok {
+					if url, ok := externalLists[listFlag]; ok {
+						fail("Word list", listFlag, "isn't built in. Download it from", url, "and use it with --dict and --diceware")
+					}
+					fail("Unknown word list:", listFlag)
+				}
+				// Built-in lists are used whole, so their entropy is as published and dice rolls line up with them
+				if !cmd.Flags().Lookup("min").Changed {
+					phraseMinFlag = 1
+				}
+				if !cmd.Flags().Lookup("max").Changed {
+					phraseMaxFlag = math.MaxInt32
+				}
+			}
+			if langFlag != "" {
+				if listFlag != "" {
+					fail("Only one of --list and --lang can be used")
+				}
+				list, err := passgen.LookupLanguage(langFlag)
+				if err != nil {
+					fail(err)
+				}
+				listFlag = list.Name
+				// Words in languages such as Japanese and Korean are often only a few characters long
+				if !cmd.Flags().Lookup("min").Changed {
+					phraseMinFlag = 1
+				}
+			}
+			var gen *passgen.PassphraseGenerator
+			var err error
+			switch {
+			case listFlag != "" && (dicewareFlag || diceFlag):
+				gen, err = passgen.NewPassphrase(passgen.WithWordList(listFlag))
+			case listFlag != "":
+				gen, err = passgen.NewPassphrase(passgen.WithWordList(listFlag), passgen.WithWordLength(phraseMinFlag, phraseMaxFlag))
+			case dicewareFlag || diceFlag:
+				gen, err = passgen.NewDicewarePassphraseGenerator(dictFlag)
+			default:
+				gen, err = passgen.NewPassphraseGenerator(dictFlag, phraseMinFlag, phraseMaxFlag)
+			}
 			if err != nil {
-				fmt.Println("Unable to create passphrase generator:", err)
+				fail("Unable to create passphrase generator:", err)
+			}
+			if (dicewareFlag || diceFlag) && gen.DicewareList() == nil {
+				fail("Word list", listFlag, "isn't a numbered Diceware list")
+			}
+			if digitsFlag < 0 || symbolsFlag < 0 || paddingFlag < 0 {
+				fail("--digits, --symbols and --padding must not be negative")
+			}
+			capitalization, ok := capitalizations[caseFlag]
+			if !ok {
+				fail("Unknown capitalization:", caseFlag)
+			}
+			gen.Format = passgen.Format{
+				Separator:      separatorFlag,
+				Separators:     passgen.NewCharset(separatorsFlag),
+				Capitalization: capitalization,
+				Digits:         digitsFlag,
+				Symbols:        symbolsFlag,
+				SymbolChars:    passgen.NewCharset(symbolCharsFlag),
+				Padding:        paddingFlag,
+			}
+			if betweenFlag {
+				gen.Format.Placement = passgen.BetweenWords
+			}
+			if bitsFlag > 0 {
+				wordFlag, err = gen.WordsForEntropy(bitsFlag)
+				if err != nil {
+					fail("Error generating passphrase:", err)
+				}
+			}
+			if err := gen.SetWordCount(wordFlag); err != nil {
+				fail("Error generating passphrase:", err)
+			}
+			if !diceFlag {
+				generate(gen)
 				return
 			}
+			bits := gen.Entropy(wordFlag)
+			closeOutput := openOutput()
+			// One scanner for every passphrase, so rolls typed or piped ahead aren't lost in its buffer
+			scanner := bufio.NewScanner(os.Stdin)
 			for i := 0; i < numFlag; i++ {
-				p := gen.GeneratePassphrase(wordFlag)
+				p, err := gen.PassphraseFromRolls(readRolls(scanner, gen.DicewareList()))
+				if err != nil {
+					fail("Error generating passphrase:", err)
+				}
+				printSecret([]byte(p), bits)
+				// Show each passphrase before asking for the next rolls
+				output.Flush()
+			}
+			closeOutput()
+		},
+	}
+
+	var profilesCmd = &cobra.Command{
+		Use:   "profiles",
+		Short: "profiles shows the types of password that can be generated.",
+		Long:  "profiles shows the generator profiles that can be used with passgen password --type.",
+		Run: func(cmd *cobra.Command, args []string) {
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tALIASES\tDESCRIPTION")
+			for _, p := range passgen.Profiles() {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", p.Name, strings.Join(p.Aliases, ", "), p.Description)
+			}
+			w.Flush()
+		},
+	}
 
-				fmt.Println(p)
+	var listsCmd = &cobra.Command{
+		Use:   "lists",
+		Short: "lists shows the built-in word lists.",
+		Long:  "lists shows the word lists built into passgen that can be used for passphrases with --list.",
+		Run: func(cmd *cobra.Command, args []string) {
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tALIASES\tLANGUAGE\tWORDS\tBITS/WORD\tDICE\tDESCRIPTION")
+			for _, l := range passgen.WordLists() {
+				dice := "-"
+				if l.Dice > 0 {
+					dice = fmt.Sprint(l.Dice)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%.2f\t%s\t%s\n", l.Name, strings.Join(l.Aliases, ", "), l.Language, l.Size, l.EntropyPerWord(), dice, l.Description)
 			}
+			w.Flush()
 		},
 	}
 
 	passwordCmd.Flags().IntVarP(&numFlag, "num", "n", 1, "number of passwords to generate")
 	passwordCmd.Flags().IntVarP(&minFlag, "min", "m", 8, "minimum length of generated password")
 	passwordCmd.Flags().IntVarP(&maxFlag, "max", "x", 14, "maximum length of generated password")
-	passwordCmd.Flags().StringVarP(&typeFlag, "type", "t", "secure", "type of password to generate. Options are (s)ecure, (a)lphanumeric, and (n)umeric")
+	passwordCmd.Flags().StringVarP(&typeFlag, "type", "t", "secure", "type of password to generate, such as (s)ecure, (a)lphanumeric, (n)umeric or alpha-lower. See passgen profiles for the options")
+	passwordCmd.Flags().BoolVarP(&entropyFlag, "show-entropy", "e", false, "print the entropy in bits next to each password")
+	passwordCmd.Flags().Float64VarP(&bitsFlag, "bits", "b", 0, "minimum bits of entropy; picks the shortest password length that reaches it instead of using min and max")
+	passwordCmd.Flags().BoolVarP(&unambiguousFlag, "unambiguous", "u", false, "leave out characters that are easily confused, such as 0/O and 1/l/I")
+	passwordCmd.Flags().StringVarP(&outputFlag, "output", "o", "", "file to write the passwords to instead of standard output")
+	passwordCmd.Flags().IntVarP(&workersFlag, "workers", "j", 1, "number of passwords to generate at once; more than 1 speeds up large batches, but doesn't keep them in order")
+	passwordCmd.Flags().BoolVar(&uniqueFlag, "unique", false, "never generate the same password twice. Fails if --num is more than half of the possible passwords")
 
 	passphraseCmd.Flags().IntVarP(&numFlag, "num", "n", 1, "number of passphrases to generate")
 	passphraseCmd.Flags().IntVarP(&wordFlag, "words", "w", 4, "number of words that the passphrase should contain")
 	passphraseCmd.Flags().IntVarP(&phraseMinFlag, "min", "m", 4, "minimum length of words to allow")
 	passphraseCmd.Flags().IntVarP(&phraseMaxFlag, "max", "x", 10, "maximum length of words to allow")
 	passphraseCmd.Flags().StringVarP(&dictFlag, "dict", "d", "internal", "dictionary file to use to find words. Uses an internal list by default")
+	passphraseCmd.Flags().StringVarP(&listFlag, "list", "l", "", "built-in word list to use instead of --dict. See passgen lists for the options. Uses every word in the list unless --min or --max is set")
+	passphraseCmd.Flags().StringVar(&langFlag, "lang", "", "language of the words, as a locale such as es, fr-CA or ja-JP. Picks a built-in word list instead of --dict, and allows words of any length unless --min is set")
+	passphraseCmd.Flags().BoolVar(&dicewareFlag, "diceware", false, "read the dictionary file as a numbered Diceware list, such as the EFF lists")
+	passphraseCmd.Flags().BoolVar(&diceFlag, "dice", false, "choose words by typing in rolls of physical dice. Needs a numbered Diceware list")
+	passphraseCmd.Flags().StringVarP(&separatorFlag, "separator", "s", " ", "separator to put between words")
+	passphraseCmd.Flags().StringVar(&separatorsFlag, "separators", "", "characters to randomly choose a separator from for each gap between words, instead of --separator")
+	passphraseCmd.Flags().StringVarP(&caseFlag, "case", "c", "none", "capitalization of words. Options are none, title, upper, lower, random, and camel")
+	passphraseCmd.Flags().IntVar(&digitsFlag, "digits", 0, "number of random digits to add")
+	passphraseCmd.Flags().IntVar(&symbolsFlag, "symbols", 0, "number of random symbols to add")
+	passphraseCmd.Flags().StringVar(&symbolCharsFlag, "symbol-chars", "", "characters to choose random symbols and padding from. Uses all ASCII symbols by default")
+	passphraseCmd.Flags().BoolVar(&betweenFlag, "between", false, "put random digits and symbols between words instead of at the end")
+	passphraseCmd.Flags().IntVar(&paddingFlag, "padding", 0, "number of times to repeat a random padding symbol at each end of the passphrase")
+	passphraseCmd.Flags().Float64VarP(&bitsFlag, "bits", "b", 0, "minimum bits of entropy; picks the smallest number of words that reaches it instead of using words")
+	passphraseCmd.Flags().BoolVarP(&entropyFlag, "show-entropy", "e", false, "print the entropy in bits next to each passphrase")
+	passphraseCmd.Flags().StringVarP(&outputFlag, "output", "o", "", "file to write the passphrases to instead of standard output")
+	passphraseCmd.Flags().IntVarP(&workersFlag, "workers", "j", 1, "number of passphrases to generate at once; more than 1 speeds up large batches, but doesn't keep them in order")
+	passphraseCmd.Flags().BoolVar(&uniqueFlag, "unique", false, "never generate the same passphrase twice. Fails if --num is more than half of the possible passphrases")
 
-	rootCmd.AddCommand(passwordCmd, passphraseCmd)
+	rootCmd.AddCommand(passwordCmd, passphraseCmd, listsCmd, profilesCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
-		println("Error starting passgen")
+		fail(err)
 	}
 	return
 
 }
+
+// Print --num secrets from the generator
+func generate(gen passgen.Generator) {
+	bits, err := gen.Bits()
+	if err != nil {
+		fail("Error generating", gen.Describe()+":", err)
+	}
+	if workersFlag < 1 {
+		fail("--workers must be at least 1")
+	}
+	// Duplicates depend on how likely the most common secrets are, such as the short ones when the length varies
+	collisionBits, err := passgen.CollisionBits(gen)
+	if err != nil {
+		fail("Error generating", gen.Describe()+":", err)
+	}
+	opts := []passgen.BatchOption{passgen.WithWorkers(workersFlag)}
+	if uniqueFlag {
+		opts = append(opts, passgen.WithUniqueSet(uniqueSet(collisionBits)))
+	} else if p := passgen.CollisionProbability(collisionBits, numFlag); p >= 0.01 {
+		fmt.Fprintf(os.Stderr, "Warning: %.3g%% chance of generating the same secret more than once. Use --unique to prevent it\n", 100*p)
+	}
+	closeOutput := openOutput()
+	for r := range passgen.Stream(context.Background(), gen, numFlag, opts...) {
+		if r.Err != nil {
+			fail("Error generating", gen.Describe()+":", r.Err)
+		}
+		printSecret(r.Secret.Bytes(), bits)
+		r.Secret.Wipe()
+	}
+	closeOutput()
+}
+
+// Get the set to remember secrets in for --unique, and report how likely duplicates would have been without it.
+// bits is the collision entropy of the secrets
+func uniqueSet(bits float64) passgen.UniqueSet {
+	fmt.Fprintf(os.Stderr, "Generating %d unique secrets, which repeat as often as about %.3g equally likely secrets. Without --unique, the chance of a duplicate would be %.3g%%\n",
+		numFlag, math.Exp2(bits), 100*passgen.CollisionProbability(bits, numFlag))
+	if numFlag <= bloomThreshold {
+		return passgen.NewMemorySet()
+	}
+	set, err := passgen.NewBloomSet(numFlag, 0.01)
+	if err != nil {
+		fail("Unable to create unique set:", err)
+	}
+	fmt.Fprintf(os.Stderr, "Remembering secrets in a %d MB Bloom filter\n", set.Size()>>20)
+	return set
+}
+
+// Send output to the --output file, or standard output. The returned function flushes and closes the output
+func openOutput() func() {
+	file := os.Stdout
+	if outputFlag != "" {
+		var err error
+		// Only the owner can read the file, since it holds secrets
+		file, err = os.OpenFile(outputFlag, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			fail("Unable to create output file:", err)
+		}
+	}
+	output = bufio.NewWriterSize(file, 64*1024)
+	return func() {
+		if err := output.Flush(); err != nil {
+			fail("Unable to write output:", err)
+		}
+		if file != os.Stdout {
+			if err := file.Close(); err != nil {
+				fail("Unable to write output:", err)
+			}
+		}
+	}
+}
+
+// Print a generated secret, along with its entropy if requested
+func printSecret(secret []byte, bits float64) {
+	output.Write(secret)
+	if entropyFlag {
+		fmt.Fprintf(output, "\t(%.1f bits)", bits)
+	}
+	output.WriteByte('\n')
+}
+
+// Report an error and exit with a non-zero status
+func fail(a ...interface{}) {
+	fmt.Fprintln(os.Stderr, a...)
+	os.Exit(1)
+}
+
+// Prompt for a roll of physical dice for each word, asking again if a roll doesn't match the list
+func readRolls(scanner *bufio.Scanner, list *passgen.DicewareList) []string {
+	rolls := make([]string, 0, wordFlag)
+	for len(rolls) < wordFlag {
+		fmt.Fprintf(os.Stderr, "Roll %d dice for word %d of %d: ", list.Dice, len(rolls)+1, wordFlag)
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				fail("\nUnable to read dice rolls:", err)
+			}
+			fail("\nUnable to read dice rolls: unexpected end of input")
+		}
+		roll := scanner.Text()
+		if _, err := list.Word(roll); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
+		rolls = append(rolls, roll)
+	}
+	return rolls
+}
@@ -1,16 +1,9 @@
 package passgen
 
 import (
-	"bufio"
-	"bytes"
-	"compress/gzip"
-	"crypto/rand"
-	"encoding/base64"
-	"encoding/gob"
-	"errors"
-	"math/big"
+	"fmt"
+	"io"
 	"os"
-	"strings"
 )
 
 // Quickly get a Passphrase according to XKCD example(http://xkcd.com/936/)
@@ -19,105 +12,129 @@ func GetXKCDPassphrase(numWords int) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return gen.GeneratePassphrase(numWords), nil
+	return gen.Passphrase(numWords)
 }
 
-// Generate a Passphrase using the configuration options of the Passphrase Generator
+// Generate a Passphrase using the configuration options of the Passphrase Generator.
+// Returns an empty string if the passphrase can't be generated.
+//
+// Deprecated: Use Passphrase, which reports why a passphrase couldn't be generated
 func (p *PassphraseGenerator) GeneratePassphrase(numWords int) string {
+	s, _ := p.Passphrase(numWords)
+	return s
+}
+
+// Generate a Passphrase of numWords words using the configuration options of the Passphrase Generator.
+// Returns ErrEmptyDictionary if the generator has no words to choose from, an error matching ErrInvalidLength if a count in its Format is negative,
+// or an error matching ErrEntropySource if random data couldn't be read
+func (p *PassphraseGenerator) Passphrase(numWords int) (string, error) {
+	b, err := p.PassphraseBytes(numWords)
+	if err != nil {
+		return "", err
+	}
+	defer wipe(b)
+	return string(b), nil
+}
+
+// Generate a Passphrase of numWords words as a byte slice, which the caller can wipe once it is no longer needed.
+// Returns the same errors as Passphrase
+func (p *PassphraseGenerator) PassphraseBytes(numWords int) ([]byte, error) {
+	if numWords < 0 {
+		return nil, fmt.Errorf("%w: number of words must not be negative", ErrInvalidLength)
+	}
+	if len(p.dict) == 0 {
+		return nil, ErrEmptyDictionary
+	}
 	words := make([]string, numWords)
-	l := big.NewInt(int64(len(p.dict)))
 	for i := 0; i < numWords; i++ {
 		// Randomly choose an index for a word from the dictionary
-		n, _ := rand.Int(rand.Reader, l)
-		words[i] = p.dict[n.Int64()]
+		n, err := randInt(entropySource(p.rand), len(p.dict))
+		if err != nil {
+			return nil, err
+		}
+		words[i] = p.dict[n]
 	}
-	// Collapse all of the chosen words into a string
-	return strings.Join(words, " ")
+	// Collapse all of the chosen words into a passphrase
+	return p.Format.apply(words, entropySource(p.rand))
 }
 
 // Get a Passphrase Generator that exceeds the XKCD example (http://xkcd.com/936/).
 // Creates a Passphrase Generator that chooses 4 words of between 4 to 10 characters long
 func GetXKCDPassphraseGenerator() (*PassphraseGenerator, error) {
-	p, err := NewPassphraseGenerator("internal", 5, 8)
-	return p, err
+	return NewPassphrase(WithWordLength(5, 8))
 }
 
-// Create a new Passphrase Generator. Use "internal" for the dictfile to use an internal list of words
+// Create a new Passphrase Generator. Use "internal" for the dictfile to use an internal list of words.
+// The other built-in lists from WordLists are chosen with NewPassphrase and WithWordList
 func NewPassphraseGenerator(dictFile string, min, max int) (*PassphraseGenerator, error) {
-	if max < min {
-		return nil, errors.New("Max length must be larger than min length")
-	}
-	p := &PassphraseGenerator{MinWordLength: min, MaxWordLength: max, DictionaryFile: dictFile}
-	var err error
-	switch dictFile {
-	case "internal":
-		err = p.loadMemoryDict()
-		if err != nil {
-			err = errors.New("Unable to load internal memory")
-		}
-	default:
-		err = p.loadDict()
-		if err != nil {
-			err = errors.New("Unable to load dictionary file")
-		}
-	}
-	return p, err
+	return NewPassphrase(WithDictionary(dictFile), WithWordLength(min, max))
 }
 
-func (p *PassphraseGenerator) loadMemoryDict() error {
-	var dict []string
-	b, err := base64.StdEncoding.DecodeString(dictStored)
-	if err != nil {
-		return errors.New("Unable to decode internal dictionary")
-	}
-	buf := bytes.NewBuffer(b)
-	z, err := gzip.NewReader(buf)
-	if err != nil {
-		return errors.New("Unable to decompress internal dictionary")
-	}
-	defer z.Close()
-	enc := gob.NewDecoder(z)
-	err = enc.Decode(&dict)
+// Create a new Passphrase Generator from the built-in word list in the language that best matches a BCP 47 locale tag, such as "es-ES" or "ja".
+// English locales use the "internal" list. Returns an error matching ErrUnsupportedLanguage if there is no list in the locale's language
+func NewLocalePassphraseGenerator(locale string, min, max int) (*PassphraseGenerator, error) {
+	return NewPassphrase(WithLanguage(locale), WithWordLength(min, max))
+}
+
+// Load one of the built-in word lists. The words are shared with every other generator using the list, and are only decoded once
+func (p *PassphraseGenerator) loadMemoryDict(list WordList) error {
+	words, err := list.words()
 	if err != nil {
-		return errors.New("Unable to decode internal dictionary")
-	}
-	for _, line := range dict {
-		if len(line) >= p.MinWordLength && len(line) <= p.MaxWordLength {
-			p.dict = append(p.dict, line)
-		}
+		return err
 	}
+	p.words = words
 	return nil
 }
 
-// Load a Dictionary File referenced in the Passphrase Generator.
-// Extract all words that meet the requirements in the Generator config
+// Load a Dictionary File referenced in the Passphrase Generator
 func (p *PassphraseGenerator) loadDict() error {
 	file, err := os.Open(p.DictionaryFile)
 	if err != nil {
-		return errors.New("Unable to open dictionary file")
+		return &DictionaryError{Path: p.DictionaryFile, Err: err}
 	}
 	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		if len(line) >= p.MinWordLength && len(line) <= p.MaxWordLength {
-			p.dict = append(p.dict, line)
-		}
-	}
-	return nil
+	return p.readDict(file, p.DictionaryFile)
 
 }
 
 // Passphrase Generator is used to generate secure passphrases
-// Can be reused to generate as many sequential passphrases as desired
+// Can be reused to generate as many sequential passphrases as desired, and shared between goroutines once it is configured
 type PassphraseGenerator struct {
 	// Path of the Dictionary file to extract words from
 	DictionaryFile string
 
-	// Minimumum and Maximum word lengths of words that should be allowed in the passphrase
+	// Minimumum and Maximum word lengths of words that should be allowed in the passphrase.
+	// Changing them after the generator is created has no effect; use SetWordLength instead
 	MinWordLength, MaxWordLength int
+	// How word lengths are counted. Characters (runes) by default
+	LengthUnit LengthUnit
+
+	// How the chosen words are put together into a passphrase
+	Format Format
+
+	// Number of words in the passphrases created by Generate, set with WithWordCount
+	numWords int
 
+	// Every word in the dictionary
+	words []string
 	// An internal slice of allowed words
 	dict []string
+	// Number of allowed words that RandomCase can change, for the entropy it adds
+	cased int
+
+	// The numbered list the words came from, if the generator was created from a Diceware list
+	diceware *DicewareList
+
+	// Source of random data, crypto/rand.Reader if nil
+	rand io.Reader
+}
+
+// Set the reader the generator gets random data from. See the package documentation on entropy sources for which readers are safe.
+// Readers known not to be cryptographically secure are refused with ErrInsecureEntropySource unless wrapped with InsecureEntropySource
+func (p *PassphraseGenerator) SetEntropySource(r io.Reader) error {
+	if err := checkEntropySource(r); err != nil {
+		return err
+	}
+	p.rand = sharedEntropySource(r)
+	return nil
 }
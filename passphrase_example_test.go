@@ -2,6 +2,7 @@ package passgen
 
 import (
 	"fmt"
+	"os"
 )
 
 func ExampleGetXKCDPassphrase() {
@@ -19,7 +20,10 @@ func ExamplePassphraseGenerator() {
 		// Handle error
 	}
 	for i := 0; i < 5; i++ {
-		p := gen.GeneratePassphrase(4)
+		p, err := gen.Passphrase(4)
+		if err != nil {
+			// Handle error
+		}
 		fmt.Println(p)
 	}
 }
@@ -31,7 +35,10 @@ func ExampleNewPassphraseGenerator() {
 		// Handle error
 	}
 	for i := 0; i < 5; i++ {
-		p := gen.GeneratePassphrase(6)
+		p, err := gen.Passphrase(6)
+		if err != nil {
+			// Handle error
+		}
 		fmt.Println(p)
 	}
 }
@@ -43,7 +50,65 @@ func ExampleNewPassphraseGenerator_dictionaryFile() {
 		// Handle error
 	}
 	for i := 0; i < 5; i++ {
-		p := gen.GeneratePassphrase(4)
+		p, err := gen.Passphrase(4)
+		if err != nil {
+			// Handle error
+		}
 		fmt.Println(p)
 	}
 }
+
+func ExampleFormat() {
+	// This example will create passphrases like "Correct-Horse-Battery-Staple42!" for password fields that reject spaces
+	gen, err := GetXKCDPassphraseGenerator()
+	if err != nil {
+		// Handle error
+	}
+	gen.Format = Format{Separator: "-", Capitalization: TitleCase, Digits: 2, Symbols: 1}
+	p, err := gen.Passphrase(4)
+	if err != nil {
+		// Handle error
+	}
+	fmt.Println(p)
+}
+
+func ExampleNewPassphraseGeneratorFromFS() {
+	// This example will create a passphrase generator from a gzip compressed word list in a filesystem, such as one from go:embed
+	fsys := os.DirFS("path/to/wordlists")
+	gen, err := NewPassphraseGeneratorFromFS(fsys, "words.txt.gz", 4, 8)
+	if err != nil {
+		// Handle error
+	}
+	p, err := gen.Passphrase(4)
+	if err != nil {
+		// Handle error
+	}
+	fmt.Println(p)
+}
+
+func ExampleNewLocalePassphraseGenerator() {
+	// This example will create a passphrase generator that uses Spanish words for users with a Spanish locale.
+	// The Spanish list is smaller than the English one, so more words are used
+	gen, err := NewLocalePassphraseGenerator("es-ES", 3, 8)
+	if err != nil {
+		// Handle error
+	}
+	p, err := gen.Passphrase(6)
+	if err != nil {
+		// Handle error
+	}
+	fmt.Println(p)
+}
+
+func ExampleNewPassphrase() {
+	// This example will create a passphrase generator that uses words of 4 to 8 letters from the EFF large list, separated by dashes
+	gen, err := NewPassphrase(WithWordList("eff-large"), WithWordLength(4, 8), WithSeparator("-"))
+	if err != nil {
+		// Handle error
+	}
+	p, err := gen.Passphrase(5)
+	if err != nil {
+		// Handle error
+	}
+	fmt.Println(p)
+}
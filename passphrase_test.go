@@ -1,7 +1,11 @@
 package passgen
 
 import (
+	"errors"
+	"io/fs"
+	mathrand "math/rand"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -13,7 +17,7 @@ func TestGetPassphrase(t *testing.T) {
 	}
 	s := strings.Split(p, " ")
 	if len(s) != 4 {
-		t.Error("Incorrect sized password returned. Expected: %d\t Actual: %d", 4, len(s))
+		t.Errorf("Incorrect sized password returned. Expected: %d\t Actual: %d", 4, len(s))
 	}
 	for _, w := range s {
 		if len(w) > 10 || len(w) < 4 {
@@ -63,3 +67,92 @@ func TestCustomPassphraseGenerator(t *testing.T) {
 	}
 
 }
+
+func TestPassphrase(t *testing.T) {
+	gen, err := GetXKCDPassphraseGenerator()
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	p, err := gen.Passphrase(6)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if s := strings.Split(p, " "); len(s) != 6 {
+		t.Errorf("Incorrect sized passphrase returned. Expected: %d\t Actual: %d", 6, len(s))
+	}
+}
+
+func TestEmptyDictionary(t *testing.T) {
+	gen, err := NewPassphraseGenerator("internal", 30, 40)
+	if !errors.Is(err, ErrEmptyDictionary) {
+		t.Error("Expected an empty dictionary error", err)
+	}
+	if _, err := gen.Passphrase(4); !errors.Is(err, ErrEmptyDictionary) {
+		t.Error("Expected an empty dictionary error", err)
+	}
+	if p := gen.GeneratePassphrase(4); p != "" {
+		t.Errorf("Expected no passphrase from an empty dictionary: %q", p)
+	}
+	if _, err := gen.WordsForEntropy(80); !errors.Is(err, ErrDictionaryTooSmall) {
+		t.Error("Expected a dictionary too small error", err)
+	}
+}
+
+func TestMissingDictionaryFile(t *testing.T) {
+	_, err := NewPassphraseGenerator("path/to/missing/dictionary", 4, 8)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Error("Expected the underlying file error to be wrapped", err)
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("device unavailable")
+}
+
+func TestPassphraseEntropySourceFailure(t *testing.T) {
+	gen, err := GetXKCDPassphraseGenerator()
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	if err := gen.SetEntropySource(failingReader{}); err != nil {
+		t.Fatal("Error setting entropy source", err)
+	}
+	if _, err := gen.Passphrase(4); !errors.Is(err, ErrEntropySource) {
+		t.Error("Expected an entropy source error", err)
+	}
+}
+
+// Share one generator between many goroutines. Run with -race to check that generating passphrases doesn't write to the generator
+func TestPassphraseGeneratorConcurrent(t *testing.T) {
+	gen, err := GetXKCDPassphraseGenerator()
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	gen.Format = Format{Separators: NewCharset("-_."), Capitalization: RandomCase, Digits: 2, Placement: BetweenWords}
+	// math/rand isn't safe for concurrent use, so this also checks that reads from the entropy source are serialized
+	if err := gen.SetEntropySource(InsecureEntropySource(mathrand.New(mathrand.NewSource(1)))); err != nil {
+		t.Fatal("Error setting entropy source", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if p := gen.GeneratePassphrase(4); p == "" {
+					t.Error("Error generating passphrase")
+					return
+				}
+				if _, err := gen.Passphrase(4); err != nil {
+					t.Error("Error generating passphrase", err)
+					return
+				}
+				gen.Entropy(4)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -1,15 +1,43 @@
 /*
 Package passgen allows for creating passwords and passphrases.
 Custom generators can be created to allow easy, full control of the types of passwords and passphrases generated
+
+# Entropy Sources
+
+Generators read random data from crypto/rand.Reader unless another entropy source is set with SetEntropySource.
+An entropy source must be a cryptographically secure random number generator for the generated secrets to be safe.
+Safe choices include crypto/rand.Reader, readers for hardware random number generators such as /dev/hwrng or an HSM,
+and deterministic random bit generators (DRBGs, such as CTR_DRBG or HMAC_DRBG) that are seeded from one of those.
+For generating secrets in bulk, NewDRBG creates a ChaCha20 DRBG that is seeded, and periodically reseeded, from crypto/rand.Reader,
+and NewBufferedEntropySource reads another entropy source in large blocks.
+
+Readers that are clearly not random - *math/rand.Rand, *bytes.Reader, *bytes.Buffer and *strings.Reader -
+are refused with ErrInsecureEntropySource. Wrap them with InsecureEntropySource to use them anyway,
+for example to get reproducible output in tests.
+
+# Concurrency
+
+Generators are safe for concurrent use by multiple goroutines once they are configured.
+Configure a generator - by setting its fields or calling SetEntropySource, SetPolicy, SetLength, SetWordLength, SetWordCount or Clean - before it is shared,
+as none of those are synchronized with generation.
+Entropy sources set with SetEntropySource don't need to be safe for concurrent use themselves; the generator serializes reads from them.
+
+# Handling Secrets
+
+Go strings can't be changed, so a password returned as a string stays in memory until the garbage collector reuses it.
+GeneratePasswordBytes and PassphraseBytes return byte slices that can be wiped once they are no longer needed,
+and Generate returns a Secret, which can be wiped with Wipe, is kept out of swap with mlock on Linux,
+and prints as "[REDACTED]" so it isn't logged by accident.
 */
 package passgen
 
 import (
 	"crypto/rand"
 	"errors"
+	"fmt"
 	"io"
 	"math"
-	"math/big"
+	"reflect"
 )
 
 // Get a secure password between min and max characters long
@@ -55,56 +83,242 @@ func GetAlphaUpperPassword(min, max int) (string, error) {
 }
 
 // Password Generator is used to generate passwords according to it's settings/properties.
-// The generator can indefinitely be used to generate passwords.
+// The generator can indefinitely be used to generate passwords, and can be shared between goroutines once it is configured.
 type PasswordGenerator struct {
 	// The ASCII Character to start pulling allowable password characters at
 	CharStart byte
-	// The number of Characters to be used for the password space
+	// The number of Characters to be used for the password space, from 1 to 256
 	CharLen int
 
 	// Function to map a number to the ASCII character that should represent it
 	Func func(i uint32) byte
 
-	rounds int
+	// The alphabet set by the constructor, or the error that made it invalid
+	alpha byteAlphabet
+	err   error
+	// The CharStart, CharLen and Func the alphabet was set from, so that changes to them are noticed
+	setStart byte
+	setLen   int
+	setFunc  uintptr
+
+	// Composition rules generated passwords must satisfy
+	policy *Policy
+
+	// Lengths of the passwords created by Password and Generate, set with WithLength or SetLength
+	minLength, maxLength int
+
+	// Source of random data, crypto/rand.Reader if nil
+	rand io.Reader
 }
 
-// Use the generator to create a password in between the given lengths
+// Use the generator to create a password in between the given lengths.
+// If the generator has a Policy, the minimum length is raised to fit all of the required characters,
+// and if every character of the alphabet is limited by a maximum, the maximum length is lowered to what those maximums allow
 func (p *PasswordGenerator) GeneratePassword(min, max int) (string, error) {
-	if max < min {
-		return "", errors.New("Max length must be larger than min length")
+	buf, err := p.GeneratePasswordBytes(min, max)
+	if err != nil {
+		return "", err
+	}
+	defer wipe(buf)
+	return string(buf), nil
+}
+
+// Use the generator to create a password in between the given lengths, as a byte slice that the caller can wipe once it is no longer needed.
+// Unlike a string, nothing else holds a copy of the password. See GeneratePassword for how the lengths are used
+func (p *PasswordGenerator) GeneratePasswordBytes(min, max int) ([]byte, error) {
+	if err := checkLength(min, max); err != nil {
+		return nil, err
+	}
+	if _, err := p.alphabet(); err != nil {
+		return nil, err
+	}
+	if p.policy != nil {
+		var err error
+		min, max, err = p.policy.checkLength(p.Charset(), min, max)
+		if err != nil {
+			return nil, err
+		}
 	}
 	length := min
 	if min != max {
-		l, err := rand.Int(rand.Reader, big.NewInt(int64(max-min)+1))
+		l, err := randInt(entropySource(p.rand), max-min+1)
 		if err != nil {
-			return "", errors.New("Unable to generate random length")
+			return nil, err
 		}
-		length = int(l.Int64()) + min
+		length = l + min
 	}
 
-	buf := make([]byte, p.GetMaxLength(max))
-	n := p.generatePassword(buf, rand.Reader)
-	//n := p.generatePassword2(buf)
-	if n < length {
-		return "", errors.New("Didn't generate enough random data")
+	buf := make([]byte, length)
+	var err error
+	if p.policy != nil {
+		err = p.generatePolicyPassword(buf, entropySource(p.rand))
+	} else {
+		_, err = p.generatePassword(buf, entropySource(p.rand))
+		//_, err = p.generatePassword2(buf)
+	}
+	if err != nil {
+		wipe(buf)
+		return nil, err
 	}
-	return string(buf[0:length]), nil
+	return buf, nil
+}
+
+// Generate a password with the lengths set by WithLength or SetLength, or 14 to 20 characters long if they weren't set
+func (p *PasswordGenerator) Password() (string, error) {
+	min, max := p.length()
+	return p.GeneratePassword(min, max)
+}
 
+// Get the lengths of the passwords created by Password
+func (p *PasswordGenerator) length() (int, int) {
+	if p.maxLength == 0 {
+		return DefaultMinLength, DefaultMaxLength
+	}
+	return p.minLength, p.maxLength
 }
 
-// Get a new Password Generator designed to start at the given starting character and use the given character space
+// Get a new Password Generator designed to start at the given starting character and use the given character space.
+// An invalid size is returned as an error by the methods of the generator that use its alphabet; NewPassword with WithCharRange returns it straight away
 func NewPasswordGenerator(start byte, size int) *PasswordGenerator {
-	p := &PasswordGenerator{CharStart: start, CharLen: size}
+	p, err := NewPassword(WithCharRange(start, size))
+	if err != nil {
+		p = &PasswordGenerator{CharStart: start, CharLen: size, err: err}
+		p.setStart, p.setLen = start, size
+	}
+	return p
+
+}
+
+// Get a new Password Generator that will draw password characters from the given Charset.
+// Only single byte (ASCII) characters are supported.
+func NewPasswordGeneratorFromCharset(c Charset) (*PasswordGenerator, error) {
+	return NewPassword(WithCharset(c))
+}
+
+// Get a Password Generator for one of the package provided Charsets, which are known to be valid
+func mustCharsetGenerator(c Charset) *PasswordGenerator {
+	p, err := NewPasswordGeneratorFromCharset(c)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
 
+// The characters a PasswordGenerator draws from, and how many of them can be drawn from each 32-bit random value
+type byteAlphabet struct {
+	chars  []byte
+	rounds int
+	// Random values at or above bias are rejected to keep every character equally likely
+	bias uint32
+}
+
+// Get the size characters starting at start, or the characters f maps the numbers 0 to size-1 to if it isn't nil.
+// Returns an error matching ErrInvalidCharset if size is out of range or f maps two numbers to the same character,
+// as repeated characters would be more likely than the others and make the password weaker than its length suggests
+func rangeAlphabet(start byte, size int, f func(i uint32) byte) ([]byte, error) {
+	if size < 1 || size > 256 {
+		return nil, fmt.Errorf("%w: size must be from 1 to 256, not %d", ErrInvalidCharset, size)
+	}
+	chars := make([]byte, size)
+	var seen [256]bool
+	for i := range chars {
+		if f != nil {
+			chars[i] = f(uint32(i))
+		} else {
+			chars[i] = start + byte(i)
+		}
+		if seen[chars[i]] {
+			return nil, fmt.Errorf("%w: %q is repeated", ErrInvalidCharset, chars[i])
+		}
+		seen[chars[i]] = true
+	}
+	return chars, nil
+}
+
+// Calculate how many characters can be drawn from each 32-bit random value, and the bound above which
+// random values must be rejected to keep every character equally likely
+func newByteAlphabet(chars []byte) byteAlphabet {
+	a := byteAlphabet{chars: chars, rounds: 1}
 	// How many characters in the given character space can be drawn from a 32-bit value?
 	for i := 2; i < 32; i++ {
-		if math.Pow(float64(p.CharLen), float64(i)) > math.MaxUint32 {
-			p.rounds = i - 1
+		if math.Pow(float64(len(chars)), float64(i)) > math.MaxUint32 {
 			break
 		}
+		a.rounds = i
 	}
-	return p
+	t := uint32(math.Pow(float64(len(chars)), float64(a.rounds)))
+	a.bias = math.MaxUint32 / t * t
+	return a
+}
+
+// Set the alphabet of a new generator, and record it in CharStart, CharLen and Func
+func (p *PasswordGenerator) setAlphabet(chars []byte) {
+	p.alpha = newByteAlphabet(chars)
+	p.CharStart, p.CharLen = chars[0], len(chars)
+	p.Func = func(i uint32) byte {
+		return chars[i]
+	}
+	p.setStart, p.setLen, p.setFunc = p.CharStart, p.CharLen, funcPointer(p.Func)
+}
+
+// Get an identifier for the code of a character function, 0 if it is nil.
+// Functions can't be compared directly, so this is what tells whether Func has been replaced
+func funcPointer(f func(i uint32) byte) uintptr {
+	if f == nil {
+		return 0
+	}
+	return reflect.ValueOf(f).Pointer()
+}
+
+// Get the alphabet of the generator. The alphabet set by the constructor is used while CharStart, CharLen and Func are unchanged;
+// otherwise it is built from them each time, returning an error matching ErrInvalidCharset if they don't make a valid alphabet
+func (p *PasswordGenerator) alphabet() (byteAlphabet, error) {
+	if (p.alpha.chars != nil || p.err != nil) && p.CharStart == p.setStart && p.CharLen == p.setLen && funcPointer(p.Func) == p.setFunc {
+		return p.alpha, p.err
+	}
+	chars, err := rangeAlphabet(p.CharStart, p.CharLen, p.Func)
+	if err != nil {
+		return byteAlphabet{}, err
+	}
+	return newByteAlphabet(chars), nil
+}
+
+// Set the reader the generator gets random data from. See the package documentation on entropy sources for which readers are safe.
+// Readers known not to be cryptographically secure are refused with ErrInsecureEntropySource unless wrapped with InsecureEntropySource
+func (p *PasswordGenerator) SetEntropySource(r io.Reader) error {
+	if err := checkEntropySource(r); err != nil {
+		return err
+	}
+	p.rand = sharedEntropySource(r)
+	return nil
+}
 
+// Get a new Password Generator using the same alphabet and Policy as this one, but without any visually ambiguous characters.
+// The characters in Ambiguous are removed unless other sets of characters to remove are given
+func (p *PasswordGenerator) Unambiguous(ambiguous ...Charset) (*PasswordGenerator, error) {
+	if len(ambiguous) == 0 {
+		ambiguous = []Charset{Ambiguous}
+	}
+	u, err := NewPasswordGeneratorFromCharset(p.Charset().Subtract(ambiguous...))
+	if err != nil {
+		return nil, err
+	}
+	if err := u.SetPolicy(p.policy); err != nil {
+		return nil, err
+	}
+	u.rand = p.rand
+	return u, nil
+}
+
+// Charset returns the set of characters the generator draws passwords from.
+// The Charset is empty if the alphabet is invalid
+func (p *PasswordGenerator) Charset() Charset {
+	alphabet, _ := p.alphabet()
+	chars := make([]rune, len(alphabet.chars))
+	for i, c := range alphabet.chars {
+		chars[i] = rune(c)
+	}
+	return newCharset(chars)
 }
 
 //Get a Password Generator that will allow ASCII x20-x7E   - 95 characters
@@ -116,19 +330,7 @@ func GetSecurePasswordGenerator() *PasswordGenerator {
 // Get a Password Generator that will only allow alphanumeric characters.
 // (A-Za-z0-9) - 62 characters
 func GetAlphaNumericPasswordGenerator() *PasswordGenerator {
-	p := NewPasswordGenerator('0', 62)
-	p.Func = func(i uint32) byte {
-		switch {
-		case i < 10:
-			return '0' + byte(i)
-		case i >= 36:
-			return 'a' + byte(i) - 36
-		default:
-			return 'A' + byte(i) - 10
-		}
-
-	}
-	return p
+	return mustCharsetGenerator(AlphaNumeric)
 }
 
 // Get a Password Generator that will only allow numeric characters.
@@ -141,17 +343,7 @@ func GetNumericPasswordGenerator() *PasswordGenerator {
 // Get a Password Generator that will only allow alphabetic characters.
 // (A-Za-z) - 52 characters
 func GetAlphaPasswordGenerator() *PasswordGenerator {
-	p := NewPasswordGenerator('A', 52)
-	p.Func = func(i uint32) byte {
-		switch {
-		case i < 26:
-			return 'A' + byte(i)
-		default:
-			return 'a' + byte(i) - 26
-		}
-
-	}
-	return p
+	return mustCharsetGenerator(Letters)
 }
 
 // Get a Password Generator that will only allow upper case alphabetic characters.
@@ -170,7 +362,8 @@ func GetAlphaLowerPasswordGenerator() *PasswordGenerator {
 
 // Get the maximum length in bytes that the generated password might need
 func (p *PasswordGenerator) GetMaxLength(n int) int {
-	l := (n + p.rounds + 1) / 4 * p.rounds
+	alphabet, _ := p.alphabet()
+	l := (n + alphabet.rounds + 1) / 4 * alphabet.rounds
 	if l > n {
 		return l
 	}
@@ -179,27 +372,37 @@ func (p *PasswordGenerator) GetMaxLength(n int) int {
 
 // Generate the password
 // Takes a random byte slice as the source and destination is a byte slice of the random data represented in the chosen character space
-// Returns the number of bytes in the destination byte slice
+// Returns the number of bytes in the destination byte slice, and an EntropyError if the source couldn't fill it
 // Hybrid model based on ASCII-85 Encode and crypto rand.Int
-func (p *PasswordGenerator) generatePassword(dst []byte, rand io.Reader) int {
+func (p *PasswordGenerator) generatePassword(dst []byte, rand io.Reader) (int, error) {
 	if rand == nil {
-		return 0
+		return 0, &EntropyError{Err: errors.New("no entropy source")}
 	}
 
+	a, err := p.alphabet()
+	if err != nil {
+		return 0, err
+	}
+	alphabet := a.chars
+
 	n := 0
 	total := len(dst)
-	var bias uint32
-	t := uint32(math.Pow(float64(p.CharLen), float64(p.rounds)))
-	d := math.MaxUint32 / t
-	bias = t * d
 
+	// Read the random data for the whole password at once, as each read can be a system call,
+	// and only read more if values fail the bias check. The random data is wiped once the password is generated
+	buf := make([]byte, 4*((total+a.rounds-1)/a.rounds))
+	defer wipe(buf)
+	var next []byte
 	for total > n {
 
-		src := make([]byte, 4)
-		nb, err := rand.Read(src)
-		if err != nil || len(src) != 4 {
-			println("Unable to fill src with random data", len(src), nb)
+		if len(next) == 0 {
+			next = buf[:4*((total-n+a.rounds-1)/a.rounds)]
+			if _, err := io.ReadFull(rand, next); err != nil {
+				return n, &EntropyError{Err: err}
+			}
 		}
+		src := next[:4]
+		next = next[4:]
 
 		// Unpack 4 bytes into uint32.
 		var v uint32
@@ -217,14 +420,14 @@ func (p *PasswordGenerator) generatePassword(dst []byte, rand io.Reader) int {
 			v |= uint32(src[0]) << 24
 		}
 
-		if v >= bias {
+		if v >= a.bias {
 			// doesn't pass bias check. Get the next set of random data
 			continue
 		}
 
 		// Determine how many rounds we can run
-		rounds := p.rounds
-		if len(dst) < p.rounds {
+		rounds := a.rounds
+		if len(dst) < a.rounds {
 			rounds = len(dst)
 		}
 		for i := 0; i < rounds; i++ {
@@ -233,31 +436,30 @@ func (p *PasswordGenerator) generatePassword(dst []byte, rand io.Reader) int {
 
 		// Loop through how ever many rounds we can get unique data from 32-bits of data
 		for i := 0; i < rounds; i++ {
-			next := v % uint32(p.CharLen)
-			if p.Func != nil {
-				dst[i] = p.Func(next)
-			} else {
-				dst[i] = p.CharStart + byte(next)
-			}
+			next := v % uint32(len(alphabet))
+			dst[i] = alphabet[next]
 
-			v /= uint32(p.CharLen)
+			v /= uint32(len(alphabet))
 		}
 
 		dst = dst[rounds:]
 		n += rounds
 	}
-	return n
+	return n, nil
 }
 
 // A secondary password generator using crypto/rand.Int for random data
-func (p *PasswordGenerator) generatePassword2(dst []byte) int {
+func (p *PasswordGenerator) generatePassword2(dst []byte) (int, error) {
+	alphabet, err := p.alphabet()
+	if err != nil {
+		return 0, err
+	}
 	for i := range dst {
-		next, _ := rand.Int(rand.Reader, big.NewInt(int64(p.CharLen)))
-		if p.Func != nil {
-			dst[i] = p.Func(uint32(next.Int64()))
-		} else {
-			dst[i] = p.CharStart + byte(uint32(next.Int64()))
+		next, err := randInt(rand.Reader, len(alphabet.chars))
+		if err != nil {
+			return i, err
 		}
+		dst[i] = alphabet.chars[next]
 	}
-	return len(dst)
+	return len(dst), nil
 }
@@ -87,3 +87,100 @@ func ExampleNewPasswordGenerator_custom() {
 		fmt.Println(p)
 	}
 }
+
+func ExampleWithCharFunc() {
+	// Make a password generator that will only return passwords containing chars of even numbers
+	gen, err := NewPassword(WithCharFunc(5, func(i uint32) byte {
+		diff := i * 2
+		return '0' + byte(diff)
+	}))
+	if err != nil {
+		//handle error
+	}
+
+	// Can now use the generator to create as many passwords as needed
+	for i := 0; i < 5; i++ {
+		p, err := gen.GeneratePassword(14, 20)
+		if err != nil {
+			//handle error
+		}
+		fmt.Println(p)
+	}
+}
+
+func ExampleNewPasswordGeneratorFromCharset() {
+	// Make a password generator that will only return passwords containing letters, digits and a few symbols
+	gen, err := NewPasswordGeneratorFromCharset(Letters.Union(Digits, NewCharset("!@#$%")))
+	if err != nil {
+		//handle error
+	}
+
+	// Can now use the generator to create as many passwords as needed
+	for i := 0; i < 5; i++ {
+		p, err := gen.GeneratePassword(14, 20)
+		if err != nil {
+			//handle error
+		}
+		fmt.Println(p)
+	}
+}
+
+func ExampleNewPasswordGeneratorFromCharset_even() {
+	// Make a password generator that will only return passwords containing even digits
+	gen, err := NewPasswordGeneratorFromCharset(Digits.Subtract(NewCharset("13579")))
+	if err != nil {
+		//handle error
+	}
+
+	p, err := gen.GeneratePassword(14, 20)
+	if err != nil {
+		//handle error
+	}
+	fmt.Println(p)
+}
+
+func ExamplePasswordGenerator_SetPolicy() {
+	// Make a password generator that always includes at least one upper case letter, two digits, and one symbol
+	gen := GetSecurePasswordGenerator()
+	err := gen.SetPolicy(&Policy{Requirements: []Requirement{
+		{Charset: Upper, Min: 1},
+		{Charset: Digits, Min: 2},
+		{Charset: Symbols, Min: 1},
+	}})
+	if err != nil {
+		//handle error
+	}
+
+	p, err := gen.GeneratePassword(14, 20)
+	if err != nil {
+		//handle error
+	}
+	fmt.Println(p)
+}
+
+func ExampleNewRunePasswordGenerator() {
+	// Make a password generator that returns Greek passwords, normalized so they can be safely stored and compared
+	gen, err := NewRunePasswordGenerator(CharRange('α', 'ω'), NFC)
+	if err != nil {
+		//handle error
+	}
+
+	p, err := gen.GeneratePassword(14, 20)
+	if err != nil {
+		//handle error
+	}
+	fmt.Println(p)
+}
+
+func ExampleNewPassword() {
+	// Make a password generator for 16 character passwords of letters and digits, leaving out characters that are easily confused
+	gen, err := NewPassword(WithCharset(AlphaNumeric), WithUnambiguous(), WithLength(16, 16))
+	if err != nil {
+		//handle error
+	}
+	p, err := gen.Password()
+	if err != nil {
+		//handle error
+	}
+	fmt.Println(p)
+}
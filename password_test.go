@@ -1,8 +1,11 @@
 package passgen
 
 import (
+	"errors"
 	"fmt"
+	mathrand "math/rand"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -191,3 +194,215 @@ func TestGetPasswordBias(t *testing.T) {
 	}
 	t.Fatal("")
 }
+
+func TestPasswordGeneratorFromCharset(t *testing.T) {
+	c := Letters.Union(Digits, NewCharset("!@#$%"))
+	gen, err := NewPasswordGeneratorFromCharset(c)
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	for i := 0; i < 5; i++ {
+		p, err := gen.GeneratePassword(14, 20)
+		if err != nil {
+			t.Fatal("Error generating password", err)
+		}
+		if len(p) > 20 || len(p) < 14 {
+			t.Error("Incorrect sized password returned")
+		}
+		for _, r := range p {
+			if !c.Contains(r) {
+				t.Errorf("Invalid character found: %c (%x)", r, r)
+				break
+			}
+		}
+	}
+	if gen.Charset().String() != c.String() {
+		t.Errorf("Incorrect generator charset. Expected: %q\t Actual: %q", c.String(), gen.Charset().String())
+	}
+}
+
+func TestPasswordGeneratorFromInvalidCharset(t *testing.T) {
+	if _, err := NewPasswordGeneratorFromCharset(Charset{}); err == nil {
+		t.Error("Expected an error for an empty charset")
+	}
+	if _, err := NewPasswordGeneratorFromCharset(NewCharset("abcé")); err == nil {
+		t.Error("Expected an error for a non ASCII charset")
+	}
+}
+
+func TestPasswordGeneratorSmallCharset(t *testing.T) {
+	for _, c := range []Charset{NewCharset("x"), NewCharset("xy")} {
+		gen, err := NewPasswordGeneratorFromCharset(c)
+		if err != nil {
+			t.Fatal("Error creating password generator", err)
+		}
+		p, err := gen.GeneratePassword(10, 10)
+		if err != nil {
+			t.Fatal("Error generating password", err)
+		}
+		if len(p) != 10 {
+			t.Error("Incorrect sized password returned")
+		}
+	}
+}
+
+func TestUnambiguousPasswordGenerator(t *testing.T) {
+	gen, err := GetSecurePasswordGenerator().Unambiguous()
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if gen.CharLen != 95-Ambiguous.Len() {
+		t.Errorf("Incorrect sized alphabet. Expected: %d\t Actual: %d", 95-Ambiguous.Len(), gen.CharLen)
+	}
+	for i := 0; i < 20; i++ {
+		p, err := gen.GeneratePassword(14, 20)
+		if err != nil {
+			t.Fatal("Error generating password", err)
+		}
+		for _, c := range p {
+			if Ambiguous.Contains(c) {
+				t.Errorf("Ambiguous character found: %c", c)
+			}
+		}
+	}
+
+	gen, err = GetNumericPasswordGenerator().Unambiguous(NewCharset("0123"))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if gen.Charset().String() != "456789" {
+		t.Errorf("Incorrect alphabet. Expected: %q\t Actual: %q", "456789", gen.Charset().String())
+	}
+}
+
+// Share one generator between many goroutines. Run with -race to check that generating passwords doesn't write to the generator
+func TestPasswordGeneratorConcurrent(t *testing.T) {
+	// math/rand isn't safe for concurrent use, so this also checks that reads from the entropy source are serialized
+	gen, err := NewPassword(WithCharFunc(5, func(i uint32) byte {
+		return '0' + byte(i*2)
+	}), WithEntropySource(InsecureEntropySource(mathrand.New(mathrand.NewSource(1)))))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	policyGen := GetSecurePasswordGenerator()
+	if err := policyGen.SetPolicy(&Policy{Requirements: []Requirement{{Charset: Digits, Min: 2}, {Charset: Symbols, Min: 1}}}); err != nil {
+		t.Fatal("Error setting policy", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				p, err := gen.GeneratePassword(8, 12)
+				if err != nil {
+					t.Error("Error generating password", err)
+					return
+				}
+				for _, c := range p {
+					if !strings.ContainsRune("02468", c) {
+						t.Errorf("Invalid character found: %c", c)
+						return
+					}
+				}
+				if _, err := policyGen.GeneratePassword(8, 12); err != nil {
+					t.Error("Error generating password", err)
+					return
+				}
+				if _, err := gen.Entropy(8, 12); err != nil {
+					t.Error("Error calculating entropy", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestInvalidPasswordGenerator(t *testing.T) {
+	for _, size := range []int{0, -1, 257} {
+		gen := &PasswordGenerator{CharStart: 'a', CharLen: size}
+		if _, err := gen.GeneratePassword(4, 8); !errors.Is(err, ErrInvalidCharset) {
+			t.Errorf("Expected ErrInvalidCharset for %d characters, got %v", size, err)
+		}
+	}
+}
+
+func TestPasswordGeneratorAlphabet(t *testing.T) {
+	// The fields record the alphabet, and changing them changes it
+	gen := NewPasswordGenerator('a', 5)
+	if gen.CharStart != 'a' || gen.CharLen != 5 || gen.Func(4) != 'e' {
+		t.Errorf("Incorrect alphabet fields: %q, %d", gen.CharStart, gen.CharLen)
+	}
+	gen.CharStart = '0'
+	gen.Func = nil
+	if gen.Charset().String() != "01234" {
+		t.Errorf("Incorrect alphabet after changing CharStart. Expected: %q\t Actual: %q", "01234", gen.Charset().String())
+	}
+	gen.Func = func(i uint32) byte {
+		return gen.CharStart + byte(i*2)
+	}
+	if gen.Charset().String() != "02468" {
+		t.Errorf("Incorrect alphabet after changing Func. Expected: %q\t Actual: %q", "02468", gen.Charset().String())
+	}
+	gen.CharLen = 3
+	if gen.Charset().String() != "024" {
+		t.Errorf("Incorrect alphabet after changing CharLen. Expected: %q\t Actual: %q", "024", gen.Charset().String())
+	}
+	p, err := gen.GeneratePassword(50, 50)
+	if err != nil {
+		t.Fatal("Error generating password", err)
+	}
+	if strings.Trim(p, "024") != "" {
+		t.Errorf("Password %q has characters outside of the changed alphabet", p)
+	}
+
+	gen = NewPasswordGenerator('a', 0)
+	gen.CharLen = 26
+	if gen.Charset().Len() != 26 {
+		t.Errorf("Expected fixing CharLen to make the alphabet valid, got %q", gen.Charset().String())
+	}
+
+	gen, err = NewPassword(WithCharFunc(5, func(i uint32) byte {
+		return '0' + byte(i*2)
+	}))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if gen.Charset().String() != "02468" {
+		t.Errorf("Incorrect alphabet. Expected: %q\t Actual: %q", "02468", gen.Charset().String())
+	}
+
+	// An invalid size is returned by the constructor, or by every use of a generator from NewPasswordGenerator
+	for _, size := range []int{0, -1, 257} {
+		if _, err := NewPassword(WithCharRange('a', size)); !errors.Is(err, ErrInvalidCharset) {
+			t.Errorf("Expected ErrInvalidCharset for %d characters, got %v", size, err)
+		}
+		if _, err := NewPassword(WithCharFunc(size, func(i uint32) byte { return 'a' })); !errors.Is(err, ErrInvalidCharset) {
+			t.Errorf("Expected ErrInvalidCharset for %d characters, got %v", size, err)
+		}
+		gen := NewPasswordGenerator('a', size)
+		if _, err := gen.Entropy(4, 8); !errors.Is(err, ErrInvalidCharset) {
+			t.Errorf("Expected ErrInvalidCharset for %d characters, got %v", size, err)
+		}
+		if gen.Charset().Len() != 0 {
+			t.Errorf("Expected an empty Charset for %d characters", size)
+		}
+	}
+	// Repeated characters are refused rather than making some characters more likely than others
+	if _, err := NewPassword(WithCharFunc(10, func(i uint32) byte { return 'a' + byte(i%2) })); !errors.Is(err, ErrInvalidCharset) {
+		t.Errorf("Expected ErrInvalidCharset for repeated characters, got %v", err)
+	}
+	gen = NewPasswordGenerator('a', 10)
+	gen.Func = func(i uint32) byte { return 'a' + byte(i%2) }
+	if _, err := gen.Entropy(16, 16); !errors.Is(err, ErrInvalidCharset) {
+		t.Errorf("Expected ErrInvalidCharset for repeated characters, got %v", err)
+	}
+	if _, err := gen.GeneratePassword(16, 16); !errors.Is(err, ErrInvalidCharset) {
+		t.Errorf("Expected ErrInvalidCharset for repeated characters, got %v", err)
+	}
+	if _, err := NewPassword(WithCharFunc(5, nil)); err == nil {
+		t.Error("Expected an error for a nil character function")
+	}
+}
@@ -0,0 +1,202 @@
+package passgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// WordFilter is one stage of a dictionary cleanup pipeline.
+// Duplicates, stray whitespace and unwanted words in a dictionary make passphrases weaker than the dictionary size suggests,
+// so dictionaries can be run through a pipeline of WordFilters before they are used
+type WordFilter struct {
+	// Name of the stage, used in DictionaryReports
+	Name string
+	// Apply returns the words that pass the stage, possibly changed. It must not modify the words slice it is given
+	Apply func(words []string) []string
+}
+
+// StageReport records how many words a stage of a cleanup pipeline removed
+type StageReport struct {
+	Name    string
+	Removed int
+}
+
+// DictionaryReport records what a cleanup pipeline did to a dictionary
+type DictionaryReport struct {
+	// Number of words before and after cleanup
+	Input, Output int
+	// The stages in the order they ran
+	Stages []StageReport
+}
+
+func (r DictionaryReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d words in", r.Input)
+	for _, s := range r.Stages {
+		fmt.Fprintf(&b, ", %s removed %d", s.Name, s.Removed)
+	}
+	fmt.Fprintf(&b, ", %d words out", r.Output)
+	return b.String()
+}
+
+// CleanWords runs words through each filter in order, returning the words that are left and a report of what each filter removed
+func CleanWords(words []string, filters ...WordFilter) ([]string, DictionaryReport) {
+	report := DictionaryReport{Input: len(words)}
+	for _, f := range filters {
+		before := len(words)
+		words = f.Apply(words)
+		report.Stages = append(report.Stages, StageReport{Name: f.Name, Removed: before - len(words)})
+	}
+	report.Output = len(words)
+	return words, report
+}
+
+// Clean runs the generator's dictionary through each filter in order, then chooses the words that meet the word length requirements again.
+// The final "length" stage of the report counts the cleaned words that don't meet the requirements.
+// Because it changes which words can be chosen, a generator created from a Diceware list can no longer be used with dice rolls after cleaning removes any words
+func (p *PassphraseGenerator) Clean(filters ...WordFilter) DictionaryReport {
+	words, report := CleanWords(p.words, filters...)
+	if len(words) != len(p.words) {
+		p.diceware = nil
+	}
+	p.words = words
+	p.filter()
+	report.Stages = append(report.Stages, StageReport{Name: "length", Removed: len(words) - len(p.dict)})
+	report.Output = len(p.dict)
+	return report
+}
+
+// DefaultCleanup is a pipeline that trims whitespace, lower cases words, removes duplicates and keeps only words made of letters
+func DefaultCleanup() []WordFilter {
+	return []WordFilter{TrimSpace(), FoldCase(), Dedupe(), OnlyLetters()}
+}
+
+// TrimSpace removes leading and trailing whitespace from words, and removes words that are left empty
+func TrimSpace() WordFilter {
+	return WordFilter{Name: "trim", Apply: func(words []string) []string {
+		var out []string
+		for _, w := range words {
+			if w = strings.TrimSpace(w); w != "" {
+				out = append(out, w)
+			}
+		}
+		return out
+	}}
+}
+
+// FoldCase lower cases words, so words that only differ by case can be removed by Dedupe
+func FoldCase() WordFilter {
+	return WordFilter{Name: "fold case", Apply: func(words []string) []string {
+		out := make([]string, len(words))
+		for i, w := range words {
+			out[i] = strings.ToLower(w)
+		}
+		return out
+	}}
+}
+
+// Dedupe removes repeated words, keeping the first of each
+func Dedupe() WordFilter {
+	return WordFilter{Name: "dedupe", Apply: func(words []string) []string {
+		seen := make(map[string]bool, len(words))
+		return keep(words, func(w string) bool {
+			if seen[w] {
+				return false
+			}
+			seen[w] = true
+			return true
+		})
+	}}
+}
+
+// OnlyChars removes words containing any character that isn't in the Charset
+func OnlyChars(c Charset) WordFilter {
+	return WordFilter{Name: "characters", Apply: func(words []string) []string {
+		return keep(words, func(w string) bool {
+			for _, r := range w {
+				if !c.Contains(r) {
+					return false
+				}
+			}
+			return true
+		})
+	}}
+}
+
+// OnlyLetters removes words containing anything other than letters, in any script, such as apostrophes, hyphens or digits
+func OnlyLetters() WordFilter {
+	return WordFilter{Name: "letters", Apply: func(words []string) []string {
+		return keep(words, func(w string) bool {
+			for _, r := range w {
+				if !unicode.IsLetter(r) {
+					return false
+				}
+			}
+			return true
+		})
+	}}
+}
+
+// Blocklist removes the given words, such as profanity. Words are compared exactly, so use it after FoldCase to block every capitalization
+func Blocklist(blocked ...string) WordFilter {
+	set := make(map[string]bool, len(blocked))
+	for _, w := range blocked {
+		set[w] = true
+	}
+	return WordFilter{Name: "blocklist", Apply: func(words []string) []string {
+		return keep(words, func(w string) bool {
+			return !set[w]
+		})
+	}}
+}
+
+// UniquePrefix removes words that share their first n characters with an earlier word,
+// so every word can be recognized, or typed with autocomplete, from its first n characters
+func UniquePrefix(n int) WordFilter {
+	return WordFilter{Name: "unique prefix", Apply: func(words []string) []string {
+		seen := make(map[string]bool, len(words))
+		return keep(words, func(w string) bool {
+			prefix := w
+			if r := []rune(w); len(r) > n {
+				prefix = string(r[:n])
+			}
+			if seen[prefix] {
+				return false
+			}
+			seen[prefix] = true
+			return true
+		})
+	}}
+}
+
+// PrefixFree removes words that are the start of another word, such as "car" when "card" is in the dictionary,
+// so passphrases without separators can only be split into words one way
+func PrefixFree() WordFilter {
+	return WordFilter{Name: "prefix free", Apply: func(words []string) []string {
+		sorted := append([]string{}, words...)
+		sort.Strings(sorted)
+		// In sorted order, a word that starts another word always starts the word right after it
+		prefixes := make(map[string]bool)
+		for i := 0; i+1 < len(sorted); i++ {
+			if sorted[i] != sorted[i+1] && strings.HasPrefix(sorted[i+1], sorted[i]) {
+				prefixes[sorted[i]] = true
+			}
+		}
+		return keep(words, func(w string) bool {
+			return !prefixes[w]
+		})
+	}}
+}
+
+// Get the words that pass the test, in their original order
+func keep(words []string, test func(w string) bool) []string {
+	var out []string
+	for _, w := range words {
+		if test(w) {
+			out = append(out, w)
+		}
+	}
+	return out
+}
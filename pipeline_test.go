@@ -0,0 +1,79 @@
+package passgen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCleanWords(t *testing.T) {
+	words := []string{" Apple", "apple ", "BANANA", "don't", "", "cherry", "darn", "x-ray", "crème"}
+	clean, report := CleanWords(words, TrimSpace(), FoldCase(), Dedupe(), OnlyLetters(), Blocklist("darn"))
+	expected := "apple banana cherry crème"
+	if strings.Join(clean, " ") != expected {
+		t.Errorf("Incorrect words. Expected: %q\t Actual: %q", expected, strings.Join(clean, " "))
+	}
+	if report.Input != 9 || report.Output != 4 {
+		t.Errorf("Incorrect report totals: %v", report)
+	}
+	removed := map[string]int{"trim": 1, "fold case": 0, "dedupe": 1, "letters": 2, "blocklist": 1}
+	for _, s := range report.Stages {
+		if removed[s.Name] != s.Removed {
+			t.Errorf("Incorrect number removed by %s. Expected: %d\t Actual: %d", s.Name, removed[s.Name], s.Removed)
+		}
+	}
+	if len(words) != 9 || words[0] != " Apple" {
+		t.Error("Cleaning should not modify the input words")
+	}
+}
+
+func TestOnlyChars(t *testing.T) {
+	clean, _ := CleanWords([]string{"abc", "abd", "xyz"}, OnlyChars(NewCharset("abcd")))
+	if strings.Join(clean, " ") != "abc abd" {
+		t.Errorf("Incorrect words: %v", clean)
+	}
+}
+
+func TestUniquePrefix(t *testing.T) {
+	clean, _ := CleanWords([]string{"absorb", "abstract", "acorn", "ab", "acid"}, UniquePrefix(3))
+	if strings.Join(clean, " ") != "absorb acorn ab acid" {
+		t.Errorf("Incorrect words: %v", clean)
+	}
+}
+
+func TestPrefixFree(t *testing.T) {
+	clean, _ := CleanWords([]string{"card", "car", "cart", "dog", "do", "cat"}, PrefixFree())
+	if strings.Join(clean, " ") != "card cart dog cat" {
+		t.Errorf("Incorrect words: %v", clean)
+	}
+}
+
+func TestCleanPassphraseGenerator(t *testing.T) {
+	gen, err := NewPassphraseGeneratorFromWords([]string{"apple", "Apple ", "banana", "ki", "kiwi "}, 5, 6)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	report := gen.Clean(DefaultCleanup()...)
+	checkDict(t, gen, "apple", "banana")
+	if report.Output != 2 {
+		t.Errorf("Incorrect report: %v", report)
+	}
+	// "ki" was always too short, and "kiwi " is too short once trimmed
+	if last := report.Stages[len(report.Stages)-1]; last.Name != "length" || last.Removed != 2 {
+		t.Errorf("Expected trimmed words to be checked for length: %v", report)
+	}
+}
+
+func TestCleanDicewareGenerator(t *testing.T) {
+	gen, err := NewPassphrase(WithWordList("eff-large"))
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	gen.Clean(TrimSpace(), Dedupe())
+	if gen.DicewareList() == nil {
+		t.Error("Cleaning that removes nothing should keep the Diceware list")
+	}
+	gen.Clean(Blocklist("abacus"))
+	if gen.DicewareList() != nil {
+		t.Error("Cleaning that removes words should drop the Diceware list")
+	}
+}
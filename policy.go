@@ -0,0 +1,267 @@
+package passgen
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"sort"
+	"sync"
+)
+
+// Requirement declares how many characters of a password must come from a character class
+type Requirement struct {
+	// The character class the requirement applies to
+	Charset Charset
+	// Minimum number of characters from Charset that must appear in the password
+	Min int
+	// Maximum number of characters from Charset that may appear in the password. 0 means no maximum
+	Max int
+}
+
+// Policy is a set of composition rules that generated passwords must satisfy,
+// such as requiring at least one digit and one symbol.
+//
+// Passwords are generated by first drawing the required characters for each Requirement,
+// then filling the rest of the password from the generator's alphabet (leaving out any character class that has reached its maximum),
+// and finally shuffling the whole password so required characters are equally likely to be at any position.
+// Generation never re-rolls whole passwords, so it always finishes in a bounded number of draws.
+type Policy struct {
+	Requirements []Requirement
+}
+
+// Attach a Policy to the generator. Passing nil removes any existing Policy.
+// The Policy is checked against the generator's alphabet and rejected with a PolicyError if it can never be satisfied.
+// The generator keeps a copy of the Policy, so changing it afterwards has no effect
+func (p *PasswordGenerator) SetPolicy(pol *Policy) error {
+	if pol != nil {
+		if err := pol.check(p.Charset()); err != nil {
+			return err
+		}
+	}
+	p.policy = pol.copy()
+	return nil
+}
+
+// Policy returns a copy of the Policy attached to the generator, or nil if there is none
+func (p *PasswordGenerator) Policy() *Policy {
+	return p.policy.copy()
+}
+
+// Get a copy of the Policy that doesn't share its Requirements
+func (pol *Policy) copy() *Policy {
+	if pol == nil {
+		return nil
+	}
+	return &Policy{Requirements: append([]Requirement{}, pol.Requirements...)}
+}
+
+// Check the parts of the Policy that don't depend on the password length
+func (pol *Policy) check(alphabet Charset) error {
+	for i, r := range pol.Requirements {
+		switch {
+		case r.Min < 0 || r.Max < 0:
+			return &PolicyError{Requirement: i, Reason: "counts must not be negative"}
+		case r.Max > 0 && r.Max < r.Min:
+			return &PolicyError{Requirement: i, Reason: "maximum is smaller than minimum"}
+		case r.Min > 0 && intersect(r.Charset, alphabet).Len() == 0:
+			return &PolicyError{Requirement: i, Reason: "none of its characters are in the generator's alphabet"}
+		}
+	}
+	for i, r := range pol.Requirements {
+		if r.Max == 0 {
+			continue
+		}
+		if forced := pol.forced(alphabet, r.Charset); forced > r.Max {
+			return &PolicyError{Requirement: i, Reason: fmt.Sprintf("other requirements force at least %d of its characters but the maximum is %d", forced, r.Max)}
+		}
+	}
+	return nil
+}
+
+// Get a lower bound on how many characters from c every password must contain because of the Policy's minimums.
+// A requirement whose characters in the alphabet are all in c forces its minimum into c.
+// Characters drawn for one requirement can count towards another that shares characters with it,
+// so minimums are only added up for requirements that share no characters, taking the largest minimums first
+func (pol *Policy) forced(alphabet, c Charset) int {
+	var inside []Requirement
+	for _, r := range pol.Requirements {
+		chars := intersect(r.Charset, alphabet)
+		if r.Min > 0 && chars.Subtract(c).Len() == 0 {
+			inside = append(inside, Requirement{Charset: chars, Min: r.Min})
+		}
+	}
+	sort.SliceStable(inside, func(i, j int) bool { return inside[i].Min > inside[j].Min })
+
+	forced := 0
+	var used Charset
+	for _, r := range inside {
+		if intersect(r.Charset, used).Len() == 0 {
+			forced += r.Min
+			used = used.Union(r.Charset)
+		}
+	}
+	return forced
+}
+
+// Check the Policy can be satisfied by passwords between min and max characters long.
+// Returns the shortest and longest lengths that can satisfy the policy: min is raised to fit the required characters,
+// and if every character is limited by a maximum, max is lowered to what the maximums allow
+func (pol *Policy) checkLength(alphabet Charset, min, max int) (int, int, error) {
+	required := 0
+	for _, r := range pol.Requirements {
+		required += r.Min
+	}
+	if required > max {
+		return 0, 0, &PolicyError{Requirement: -1, Reason: fmt.Sprintf("requires %d characters but the maximum length is %d", required, max)}
+	}
+	if required > min {
+		min = required
+	}
+
+	// If every character is limited by a maximum, the maximums must leave room for the shortest password, and cap the longest
+	unlimited := alphabet
+	limit := 0
+	for _, r := range pol.Requirements {
+		if r.Max > 0 {
+			unlimited = unlimited.Subtract(r.Charset)
+			limit += r.Max
+		}
+	}
+	if unlimited.Len() == 0 {
+		if limit < min {
+			return 0, 0, &PolicyError{Requirement: -1, Reason: fmt.Sprintf("maximums only allow %d characters but the minimum length is %d", limit, min)}
+		}
+		if limit < max {
+			max = limit
+		}
+	}
+	return min, max, nil
+}
+
+// Get a lower bound on the entropy of a password of the given length generated with the Policy
+func (pol *Policy) entropy(alphabet Charset, length int) float64 {
+	bits := 0.0
+	fill := alphabet
+	var limited []Charset
+	for _, r := range pol.Requirements {
+		if r.Min > 0 {
+			bits += float64(r.Min) * math.Log2(float64(intersect(r.Charset, alphabet).Len()))
+			length -= r.Min
+		}
+		if r.Max > 0 {
+			fill = fill.Subtract(r.Charset)
+			limited = append(limited, intersect(r.Charset, alphabet))
+		}
+	}
+	if fill.Len() == 0 {
+		// Every character is limited by a maximum, so count the smallest class
+		for _, c := range limited {
+			if fill.Len() == 0 || c.Len() < fill.Len() {
+				fill = c
+			}
+		}
+	}
+	if length > 0 && fill.Len() > 0 {
+		bits += float64(length) * math.Log2(float64(fill.Len()))
+	}
+	return bits
+}
+
+// Generate a password of the given length that satisfies the generator's Policy
+func (p *PasswordGenerator) generatePolicyPassword(dst []byte, rand io.Reader) error {
+	alphabet := p.Charset()
+	reqs := p.policy.Requirements
+	counts := make([]int, len(reqs))
+
+	// Characters that can still be added without breaking a maximum
+	allowed := func(c Charset) Charset {
+		for i, r := range reqs {
+			if r.Max > 0 && counts[i] >= r.Max {
+				c = c.Subtract(r.Charset)
+			}
+		}
+		return c
+	}
+	add := func(n int, c Charset) error {
+		chars := c.Runes()
+		if len(chars) == 0 {
+			return &PolicyError{Requirement: -1, Reason: "character class maximums conflict with the required characters"}
+		}
+		next, err := randInt(rand, len(chars))
+		if err != nil {
+			return err
+		}
+		dst[n] = byte(chars[next])
+		for i, r := range reqs {
+			if r.Charset.Contains(chars[next]) {
+				counts[i]++
+			}
+		}
+		return nil
+	}
+
+	n := 0
+	for i, r := range reqs {
+		for counts[i] < r.Min {
+			if err := add(n, allowed(intersect(r.Charset, alphabet))); err != nil {
+				return err
+			}
+			n++
+		}
+	}
+	for ; n < len(dst); n++ {
+		if err := add(n, allowed(alphabet)); err != nil {
+			return err
+		}
+	}
+
+	// Shuffle so the required characters don't sit at the start of the password
+	for i := len(dst) - 1; i > 0; i-- {
+		j, err := randInt(rand, i+1)
+		if err != nil {
+			return err
+		}
+		dst[i], dst[j] = dst[j], dst[i]
+	}
+	return nil
+}
+
+// Get the characters that are in both Charsets
+func intersect(a, b Charset) Charset {
+	return a.Subtract(a.Subtract(b))
+}
+
+// Buffers for randInt, reused so that no memory is allocated for each character
+var randBuffers = sync.Pool{New: func() interface{} { return new([8]byte) }}
+
+// Get a uniformly random number in [0, n).
+// Reads random data exactly like crypto/rand.Int, so the same entropy source gives the same results, but without allocating a big.Int
+func randInt(r io.Reader, n int) (int, error) {
+	max := uint64(n - 1)
+	if max == 0 {
+		return 0, nil
+	}
+	// Read just enough bytes to hold max, masking off the unused high bits, and try again if the value is too big
+	bitLen := bits.Len64(max)
+	k := (bitLen + 7) / 8
+	mask := byte(1<<((bitLen-1)%8+1) - 1)
+
+	buf := randBuffers.Get().(*[8]byte)
+	defer randBuffers.Put(buf)
+	defer wipe(buf[:])
+	src := buf[:k]
+	for {
+		if _, err := io.ReadFull(r, src); err != nil {
+			return 0, &EntropyError{Err: err}
+		}
+		src[0] &= mask
+		var v uint64
+		for _, b := range src {
+			v = v<<8 | uint64(b)
+		}
+		if v <= max {
+			return int(v), nil
+		}
+	}
+}
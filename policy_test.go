@@ -0,0 +1,169 @@
+package passgen
+
+import (
+	"errors"
+	"testing"
+)
+
+func countIn(p string, c Charset) int {
+	n := 0
+	for _, r := range p {
+		if c.Contains(r) {
+			n++
+		}
+	}
+	return n
+}
+
+func TestPolicyPassword(t *testing.T) {
+	gen := GetSecurePasswordGenerator()
+	err := gen.SetPolicy(&Policy{Requirements: []Requirement{
+		{Charset: Upper, Min: 2},
+		{Charset: Digits, Min: 3},
+		{Charset: Symbols, Min: 1, Max: 2},
+	}})
+	if err != nil {
+		t.Fatal("Error setting policy", err)
+	}
+	for i := 0; i < 100; i++ {
+		p, err := gen.GeneratePassword(8, 12)
+		if err != nil {
+			t.Fatal("Error generating password", err)
+		}
+		if len(p) > 12 || len(p) < 8 {
+			t.Error("Incorrect sized password returned")
+		}
+		if countIn(p, Upper) < 2 || countIn(p, Digits) < 3 {
+			t.Errorf("Password does not contain the required characters: %q", p)
+		}
+		if n := countIn(p, Symbols); n < 1 || n > 2 {
+			t.Errorf("Password contains the wrong number of symbols: %q", p)
+		}
+	}
+}
+
+func TestPolicyRaisesMinLength(t *testing.T) {
+	gen := GetAlphaNumericPasswordGenerator()
+	if err := gen.SetPolicy(&Policy{Requirements: []Requirement{{Charset: Digits, Min: 6}}}); err != nil {
+		t.Fatal("Error setting policy", err)
+	}
+	p, err := gen.GeneratePassword(4, 6)
+	if err != nil {
+		t.Fatal("Error generating password", err)
+	}
+	if len(p) != 6 || countIn(p, Digits) != 6 {
+		t.Errorf("Incorrect password returned: %q", p)
+	}
+}
+
+func TestPolicyLowersMaxLength(t *testing.T) {
+	// Every digit is limited by the maximum, so lengths past it can't be generated
+	pol := &Policy{Requirements: []Requirement{{Charset: Digits, Max: 5}}}
+	gen := GetNumericPasswordGenerator()
+	if err := gen.SetPolicy(pol); err != nil {
+		t.Fatal("Error setting policy", err)
+	}
+	for i := 0; i < 200; i++ {
+		p, err := gen.GeneratePassword(4, 10)
+		if err != nil {
+			t.Fatal("Error generating password", err)
+		}
+		if len(p) < 4 || len(p) > 5 {
+			t.Errorf("Incorrect sized password returned: %q", p)
+		}
+	}
+
+	bits, err := gen.Entropy(4, 10)
+	if err != nil {
+		t.Fatal("Error getting entropy", err)
+	}
+	if expected, _ := gen.Entropy(4, 5); bits != expected {
+		t.Errorf("Incorrect entropy. Expected: %v\t Actual: %v", expected, bits)
+	}
+	if err := gen.SetLength(4, 10); err != nil {
+		t.Error("Error setting length", err)
+	}
+	if _, err := NewPassword(WithCharRange('0', 10), WithPolicy(pol), WithLength(4, 10)); err != nil {
+		t.Error("Error creating password generator", err)
+	}
+	if _, err := gen.LengthForEntropy(20); !errors.Is(err, ErrPolicyUnsatisfiable) {
+		t.Error("Expected a policy error when the length needed is past the maximums", err)
+	}
+}
+
+func TestPolicyPosition(t *testing.T) {
+	// The required digit must be equally likely to land in any position
+	gen, err := NewPasswordGeneratorFromCharset(Lower.Union(NewCharset("0")))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if err := gen.SetPolicy(&Policy{Requirements: []Requirement{{Charset: NewCharset("0"), Min: 1, Max: 1}}}); err != nil {
+		t.Fatal("Error setting policy", err)
+	}
+	N := 4000
+	var freq [4]int
+	for i := 0; i < N; i++ {
+		p, err := gen.GeneratePassword(4, 4)
+		if err != nil {
+			t.Fatal("Error generating password", err)
+		}
+		for j, c := range p {
+			if c == '0' {
+				freq[j]++
+			}
+		}
+	}
+	for i, cnt := range freq {
+		if cnt < N/4-N/10 || cnt > N/4+N/10 {
+			t.Errorf("Required character is biased towards position %d: %v", i, freq)
+		}
+	}
+}
+
+func TestImpossiblePolicy(t *testing.T) {
+	gen := GetNumericPasswordGenerator()
+	err := gen.SetPolicy(&Policy{Requirements: []Requirement{{Charset: Upper, Min: 1}}})
+	if !errors.Is(err, ErrPolicyUnsatisfiable) {
+		t.Error("Expected a policy error for characters outside the alphabet", err)
+	}
+	err = gen.SetPolicy(&Policy{Requirements: []Requirement{{Charset: Digits, Min: 3, Max: 2}}})
+	if !errors.Is(err, ErrPolicyUnsatisfiable) {
+		t.Error("Expected a policy error for a maximum below the minimum", err)
+	}
+
+	// Characters required by one requirement can break the maximum of another
+	overlap := &Policy{Requirements: []Requirement{{Charset: Digits, Min: 3}, {Charset: NewCharset("0123456789abc"), Max: 2}}}
+	err = GetAlphaNumericPasswordGenerator().SetPolicy(overlap)
+	var overlapErr *PolicyError
+	if !errors.As(err, &overlapErr) || overlapErr.Requirement != 1 {
+		t.Error("Expected a policy error for a maximum broken by another requirement's minimum", err)
+	}
+	if _, err := NewPassword(WithCharset(AlphaNumeric), WithPolicy(overlap)); !errors.Is(err, ErrPolicyUnsatisfiable) {
+		t.Error("Expected NewPassword to reject a maximum broken by another requirement's minimum", err)
+	}
+	// Requirements that share characters can be satisfied by the same characters
+	shared := &Policy{Requirements: []Requirement{{Charset: NewCharset("01"), Min: 2}, {Charset: Digits, Min: 2}, {Charset: Digits, Max: 2}}}
+	alnum := GetAlphaNumericPasswordGenerator()
+	if err := alnum.SetPolicy(shared); err != nil {
+		t.Error("Policy with requirements sharing characters should be allowed", err)
+	}
+	if s, err := alnum.GeneratePassword(6, 6); err != nil || countIn(s, NewCharset("01")) != 2 {
+		t.Errorf("Incorrect password %q for requirements sharing characters: %v", s, err)
+	}
+
+	if err := gen.SetPolicy(&Policy{Requirements: []Requirement{{Charset: Digits, Min: 10}}}); err != nil {
+		t.Fatal("Error setting policy", err)
+	}
+	_, err = gen.GeneratePassword(4, 8)
+	var perr *PolicyError
+	if !errors.As(err, &perr) {
+		t.Error("Expected a policy error when requirements exceed the maximum length", err)
+	}
+
+	if err := gen.SetPolicy(&Policy{Requirements: []Requirement{{Charset: Digits, Max: 2}}}); err != nil {
+		t.Fatal("Error setting policy", err)
+	}
+	if _, err = gen.GeneratePassword(4, 8); !errors.Is(err, ErrPolicyUnsatisfiable) {
+		t.Error("Expected a policy error when maximums are shorter than the password", err)
+	}
+}
@@ -0,0 +1,154 @@
+package passgen
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Profile is a named recipe for a Generator, such as "secure" or "xkcd".
+// Profiles let command line tools, config files and other packages refer to the same kinds of generator by name.
+// Packages can add their own profiles with RegisterProfile
+type Profile struct {
+	// Name used to select the profile
+	Name string
+	// Other names that also select the profile
+	Aliases []string
+	// Short description of the secrets the profile generates
+	Description string
+	// Create a new Generator for the profile. Each call should return a new Generator, so callers can configure it without affecting others
+	New func() (Generator, error)
+}
+
+// The registered profiles, by name and alias
+var profiles = struct {
+	sync.RWMutex
+	byName map[string]*Profile
+}{byName: make(map[string]*Profile)}
+
+func init() {
+	for _, p := range []Profile{
+		{
+			Name:        "secure",
+			Aliases:     []string{"s"},
+			Description: "Printable ASCII characters, including symbols and space - 95 characters",
+			New: func() (Generator, error) {
+				return GetSecurePasswordGenerator(), nil
+			},
+		},
+		{
+			Name:        "alphanumeric",
+			Aliases:     []string{"a"},
+			Description: "Letters and digits (A-Za-z0-9) - 62 characters",
+			New: func() (Generator, error) {
+				return GetAlphaNumericPasswordGenerator(), nil
+			},
+		},
+		{
+			Name:        "numeric",
+			Aliases:     []string{"n"},
+			Description: "Digits (0-9) - 10 characters",
+			New: func() (Generator, error) {
+				return GetNumericPasswordGenerator(), nil
+			},
+		},
+		{
+			Name:        "alpha",
+			Description: "Letters (A-Za-z) - 52 characters",
+			New: func() (Generator, error) {
+				return GetAlphaPasswordGenerator(), nil
+			},
+		},
+		{
+			Name:        "alpha-upper",
+			Aliases:     []string{"upper"},
+			Description: "Upper case letters (A-Z) - 26 characters",
+			New: func() (Generator, error) {
+				return GetAlphaUpperPasswordGenerator(), nil
+			},
+		},
+		{
+			Name:        "alpha-lower",
+			Aliases:     []string{"lower"},
+			Description: "Lower case letters (a-z) - 26 characters",
+			New: func() (Generator, error) {
+				return GetAlphaLowerPasswordGenerator(), nil
+			},
+		},
+		{
+			Name:        "xkcd",
+			Description: "Passphrase of 4 words of 5 to 8 letters from the internal dictionary (http://xkcd.com/936/)",
+			New: func() (Generator, error) {
+				return GetXKCDPassphraseGenerator()
+			},
+		},
+	} {
+		if err := RegisterProfile(p); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterProfile adds a profile to the registry so it can be found by LookupProfile and NewGenerator.
+// Returns an error matching ErrDuplicateProfile if its name or one of its aliases is already taken, and ErrInvalidProfile if it has no name or New function
+func RegisterProfile(p Profile) error {
+	if p.Name == "" || p.New == nil {
+		return fmt.Errorf("%w: profile must have a name and a New function", ErrInvalidProfile)
+	}
+	p.Aliases = append([]string{}, p.Aliases...)
+
+	profiles.Lock()
+	defer profiles.Unlock()
+	names := append([]string{p.Name}, p.Aliases...)
+	for _, name := range names {
+		if _, ok := profiles.byName[name]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateProfile, name)
+		}
+	}
+	for _, name := range names {
+		profiles.byName[name] = &p
+	}
+	return nil
+}
+
+// LookupProfile finds a registered profile by its name or one of its aliases
+func LookupProfile(name string) (Profile, bool) {
+	profiles.RLock()
+	defer profiles.RUnlock()
+	p, ok := profiles.byName[name]
+	if !ok {
+		return Profile{}, false
+	}
+	return p.copy(), true
+}
+
+// Profiles returns the registered profiles, sorted by name
+func Profiles() []Profile {
+	profiles.RLock()
+	defer profiles.RUnlock()
+	var list []Profile
+	for name, p := range profiles.byName {
+		if name == p.Name {
+			list = append(list, p.copy())
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// Get a copy of the profile that doesn't share its Aliases with the registry
+func (p *Profile) copy() Profile {
+	c := *p
+	c.Aliases = append([]string{}, p.Aliases...)
+	return c
+}
+
+// NewGenerator creates a Generator from the registered profile with the given name or alias.
+// Returns an error matching ErrUnknownProfile if there is no such profile
+func NewGenerator(name string) (Generator, error) {
+	p, ok := LookupProfile(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProfile, name)
+	}
+	return p.New()
+}
@@ -0,0 +1,86 @@
+package passgen
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestBuiltinProfiles(t *testing.T) {
+	tests := []struct {
+		name, alphabet string
+	}{
+		{"secure", Printable.String()},
+		{"s", Printable.String()},
+		{"alphanumeric", AlphaNumeric.String()},
+		{"n", Digits.String()},
+		{"alpha", Letters.String()},
+		{"upper", Upper.String()},
+		{"alpha-lower", Lower.String()},
+	}
+	for _, test := range tests {
+		gen, err := NewGenerator(test.name)
+		if err != nil {
+			t.Fatalf("Error creating generator %s: %v", test.name, err)
+		}
+		if c := gen.(*PasswordGenerator).Charset().String(); c != test.alphabet {
+			t.Errorf("Incorrect alphabet for %s. Expected: %q\t Actual: %q", test.name, test.alphabet, c)
+		}
+	}
+
+	gen, err := NewGenerator("xkcd")
+	if err != nil {
+		t.Fatal("Error creating generator", err)
+	}
+	if _, err := gen.Generate(context.Background()); err != nil {
+		t.Error("Error generating passphrase", err)
+	}
+
+	if _, err := NewGenerator("unknown"); !errors.Is(err, ErrUnknownProfile) {
+		t.Errorf("Expected ErrUnknownProfile, got %v", err)
+	}
+}
+
+func TestRegisterProfile(t *testing.T) {
+	p := Profile{
+		Name:        "test-pin",
+		Aliases:     []string{"test-code"},
+		Description: "6 digit PIN",
+		New: func() (Generator, error) {
+			return NewPassword(WithCharset(Digits), WithLength(6, 6))
+		},
+	}
+	if err := RegisterProfile(p); err != nil {
+		t.Fatal("Error registering profile", err)
+	}
+	gen, err := NewGenerator("test-code")
+	if err != nil {
+		t.Fatal("Error creating generator", err)
+	}
+	if s, err := gen.Generate(context.Background()); err != nil || s.Len() != 6 {
+		t.Errorf("Incorrect secret %q: %v", s.Value(), err)
+	}
+
+	found := false
+	for _, l := range Profiles() {
+		if l.Name == "test-pin" {
+			found = true
+		}
+		if l.Name == "test-code" {
+			t.Error("Aliases should not be listed as profiles")
+		}
+	}
+	if !found {
+		t.Error("Registered profile not listed")
+	}
+
+	if err := RegisterProfile(Profile{Name: "other", Aliases: []string{"test-pin"}, New: p.New}); !errors.Is(err, ErrDuplicateProfile) {
+		t.Errorf("Expected ErrDuplicateProfile, got %v", err)
+	}
+	if _, ok := LookupProfile("other"); ok {
+		t.Error("A profile that failed to register should not be found")
+	}
+	if err := RegisterProfile(Profile{Name: "no-new"}); !errors.Is(err, ErrInvalidProfile) {
+		t.Errorf("Expected ErrInvalidProfile, got %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package passgen
+
+import (
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalization selects the Unicode normalization form that generated passwords are guaranteed to be in
+type Normalization int
+
+const (
+	// Don't check the alphabet against any normalization form
+	NoNormalization Normalization = iota
+	// Canonical composition - https://unicode.org/reports/tr15/
+	NFC
+	// Compatibility composition - https://unicode.org/reports/tr15/
+	NFKC
+)
+
+// Get the x/text form for the normalization
+func (n Normalization) form() (norm.Form, bool) {
+	switch n {
+	case NFC:
+		return norm.NFC, true
+	case NFKC:
+		return norm.NFKC, true
+	}
+	return 0, false
+}
+
+// Rune Password Generator is used to generate passwords from an alphabet of Unicode characters of any size,
+// such as Cyrillic, Greek, CJK or emoji.
+// Password lengths are counted in characters (runes) rather than bytes, and generated passwords are valid UTF-8.
+// The generator can indefinitely be used to generate passwords.
+type RunePasswordGenerator struct {
+	chars []rune
+
+	normalization Normalization
+
+	// Lengths of the passwords created by Generate, set with SetLength
+	minLength, maxLength int
+
+	// Source of random data, crypto/rand.Reader if nil
+	rand io.Reader
+}
+
+// Get a new Rune Password Generator that will draw password characters from the given Charset.
+//
+// If a normalization form is given, every character in the Charset must already be in that form
+// and must not combine with the character before it, so generated passwords come out normalized
+// and survive a round trip through systems that normalize their input.
+func NewRunePasswordGenerator(c Charset, normalization Normalization) (*RunePasswordGenerator, error) {
+	if c.Len() == 0 {
+		return nil, fmt.Errorf("%w: must contain at least one character", ErrInvalidCharset)
+	}
+	// Surrogates and runes past the end of Unicode can't be encoded in UTF-8, and would all come out as U+FFFD
+	for _, r := range c.chars {
+		if !utf8.ValidRune(r) {
+			return nil, fmt.Errorf("%w: %U is not a valid Unicode character", ErrInvalidCharset, r)
+		}
+	}
+	if form, ok := normalization.form(); ok {
+		for _, r := range c.chars {
+			s := string(r)
+			props := form.PropertiesString(s)
+			if !form.IsNormalString(s) || !props.BoundaryBefore() {
+				return nil, fmt.Errorf("%w: character %q (%U) is not stable under normalization", ErrInvalidCharset, r, r)
+			}
+		}
+	}
+	return &RunePasswordGenerator{chars: c.Runes(), normalization: normalization}, nil
+}
+
+// Use the generator to create a password in between the given lengths, counted in characters
+func (p *RunePasswordGenerator) GeneratePassword(min, max int) (string, error) {
+	buf, err := p.GeneratePasswordBytes(min, max)
+	if err != nil {
+		return "", err
+	}
+	defer wipe(buf)
+	return string(buf), nil
+}
+
+// Use the generator to create a password in between the given lengths, counted in characters, as UTF-8 encoded bytes
+// that the caller can wipe once they are no longer needed. The characters are chosen in a separate buffer, which is wiped once they are encoded
+func (p *RunePasswordGenerator) GeneratePasswordBytes(min, max int) ([]byte, error) {
+	if err := checkLength(min, max); err != nil {
+		return nil, err
+	}
+	length := min
+	if min != max {
+		l, err := randInt(entropySource(p.rand), max-min+1)
+		if err != nil {
+			return nil, err
+		}
+		length = l + min
+	}
+
+	password := make([]rune, length)
+	defer func() {
+		for i := range password {
+			password[i] = 0
+		}
+	}()
+	size := 0
+	for i := range password {
+		next, err := randInt(entropySource(p.rand), len(p.chars))
+		if err != nil {
+			return nil, err
+		}
+		password[i] = p.chars[next]
+		size += utf8.RuneLen(password[i])
+	}
+	buf := make([]byte, size)
+	n := 0
+	for _, r := range password {
+		n += utf8.EncodeRune(buf[n:], r)
+	}
+	return buf, nil
+}
+
+// Charset returns the set of characters the generator draws passwords from
+func (p *RunePasswordGenerator) Charset() Charset {
+	return Charset{chars: p.chars}
+}
+
+// Normalization returns the normalization form generated passwords are in
+func (p *RunePasswordGenerator) Normalization() Normalization {
+	return p.normalization
+}
+
+// Set the reader the generator gets random data from. See the package documentation on entropy sources for which readers are safe.
+// Readers known not to be cryptographically secure are refused with ErrInsecureEntropySource unless wrapped with InsecureEntropySource
+func (p *RunePasswordGenerator) SetEntropySource(r io.Reader) error {
+	if err := checkEntropySource(r); err != nil {
+		return err
+	}
+	p.rand = sharedEntropySource(r)
+	return nil
+}
@@ -0,0 +1,87 @@
+package passgen
+
+import (
+	"errors"
+	"testing"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestRunePasswordGenerator(t *testing.T) {
+	c := CharRange('а', 'я').Union(CharRange('α', 'ω'), NewCharset("😀🔑水火"))
+	gen, err := NewRunePasswordGenerator(c, NFC)
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	for i := 0; i < 20; i++ {
+		p, err := gen.GeneratePassword(14, 20)
+		if err != nil {
+			t.Fatal("Error generating password", err)
+		}
+		if !utf8.ValidString(p) {
+			t.Errorf("Invalid UTF-8 password returned: %q", p)
+		}
+		if n := utf8.RuneCountInString(p); n > 20 || n < 14 {
+			t.Error("Incorrect sized password returned")
+		}
+		if !norm.NFC.IsNormalString(p) {
+			t.Errorf("Password is not NFC normalized: %q", p)
+		}
+		for _, r := range p {
+			if !c.Contains(r) {
+				t.Errorf("Invalid character found: %c (%x)", r, r)
+				break
+			}
+		}
+	}
+}
+
+func TestInvalidRunePasswordGenerator(t *testing.T) {
+	for _, c := range []Charset{
+		{},
+		CharRange(0xD800, 0xDFFF), // Surrogates
+		CharRange('a', 'z').Union(CharRange(0xDC00, 0xDC00)),
+		CharRange(utf8.MaxRune, utf8.MaxRune+1), // Past the end of Unicode
+		CharRange(-1, 0),
+	} {
+		for _, n := range []Normalization{NoNormalization, NFC} {
+			if _, err := NewRunePasswordGenerator(c, n); !errors.Is(err, ErrInvalidCharset) {
+				t.Errorf("Expected ErrInvalidCharset for %d characters from %U, got %v", c.Len(), c.Runes(), err)
+			}
+		}
+	}
+}
+
+func TestLargeRunePasswordGenerator(t *testing.T) {
+	// CJK Unified Ideographs - far more than 256 characters
+	c := CharRange(0x4E00, 0x9FFF)
+	gen, err := NewRunePasswordGenerator(c, NFKC)
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	p, err := gen.GeneratePassword(8, 8)
+	if err != nil {
+		t.Fatal("Error generating password", err)
+	}
+	if utf8.RuneCountInString(p) != 8 {
+		t.Error("Incorrect sized password returned")
+	}
+}
+
+func TestRunePasswordGeneratorNormalization(t *testing.T) {
+	// A combining accent would merge with the character before it
+	if _, err := NewRunePasswordGenerator(NewCharset("abć"), NFC); err == nil {
+		t.Error("Expected an error for a combining character")
+	}
+	// The fi ligature is decomposed by NFKC
+	if _, err := NewRunePasswordGenerator(NewCharset("abcﬁ"), NFKC); err == nil {
+		t.Error("Expected an error for a character changed by NFKC")
+	}
+	if _, err := NewRunePasswordGenerator(NewCharset("abcﬁ"), NoNormalization); err != nil {
+		t.Error("Unexpected error without normalization", err)
+	}
+	if _, err := NewRunePasswordGenerator(Charset{}, NFC); err == nil {
+		t.Error("Expected an error for an empty charset")
+	}
+}
@@ -0,0 +1,114 @@
+package passgen
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// Secret is a password, passphrase or other secret created by a Generator.
+//
+// The secret is kept in a byte slice rather than a string, so it can be wiped with Wipe once it is no longer needed.
+// On Linux its memory is locked with mlock, where the process is allowed to, so it isn't written to swap.
+// A Secret prints as "[REDACTED]" with fmt, String and text or JSON encoding, so it doesn't end up in logs by accident;
+// use Bytes or Value to get the secret itself.
+// Copies of a Secret share the same memory, so wiping one wipes them all.
+// A Secret that is dropped without being wiped is wiped when it is garbage collected.
+type Secret struct {
+	buf *secretBuffer
+}
+
+// The memory of a Secret, shared by its copies
+type secretBuffer struct {
+	mu     sync.Mutex
+	value  []byte
+	locked bool
+}
+
+// What a Secret prints as
+const redacted = "[REDACTED]"
+
+// Create a Secret holding a copy of value. Wipe value once the Secret has been created if it isn't needed anymore
+func NewSecret(value []byte) Secret {
+	b := &secretBuffer{value: make([]byte, len(value))}
+	b.locked = len(value) > 0 && mlock(b.value) == nil
+	copy(b.value, value)
+	runtime.SetFinalizer(b, (*secretBuffer).wipe)
+	return Secret{buf: b}
+}
+
+// Bytes returns the memory holding the secret. It is zeroed when the Secret is wiped, so copy it if it is needed for longer
+func (s Secret) Bytes() []byte {
+	if s.buf == nil {
+		return nil
+	}
+	s.buf.mu.Lock()
+	defer s.buf.mu.Unlock()
+	return s.buf.value
+}
+
+// Value returns a copy of the secret as a string. Strings can't be wiped, so prefer Bytes where the secret can be used as a byte slice
+func (s Secret) Value() string {
+	return string(s.Bytes())
+}
+
+// Len returns the length of the secret in bytes, or 0 once it has been wiped
+func (s Secret) Len() int {
+	return len(s.Bytes())
+}
+
+// Locked reports whether the memory holding the secret is locked so it can't be written to swap
+func (s Secret) Locked() bool {
+	if s.buf == nil {
+		return false
+	}
+	s.buf.mu.Lock()
+	defer s.buf.mu.Unlock()
+	return s.buf.locked
+}
+
+// Wipe overwrites the secret with zeros and unlocks its memory. The Secret is empty afterwards
+func (s Secret) Wipe() {
+	if s.buf != nil {
+		s.buf.wipe()
+	}
+}
+
+func (b *secretBuffer) wipe() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	wipe(b.value)
+	if b.locked {
+		munlock(b.value)
+		b.locked = false
+	}
+	b.value = nil
+}
+
+// String returns "[REDACTED]" rather than the secret
+func (s Secret) String() string {
+	return redacted
+}
+
+// GoString returns "[REDACTED]" rather than the secret, for the %#v verb
+func (s Secret) GoString() string {
+	return redacted
+}
+
+// Format prints "[REDACTED]" for every fmt verb
+func (s Secret) Format(f fmt.State, verb rune) {
+	io.WriteString(f, redacted)
+}
+
+// MarshalText returns "[REDACTED]", so encoding a Secret, such as in a JSON log entry, doesn't reveal it
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(redacted), nil
+}
+
+// Overwrite b with zeros
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,155 @@
+package passgen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSecretRedacted(t *testing.T) {
+	s := NewSecret([]byte("hunter2"))
+	defer s.Wipe()
+
+	for _, out := range []string{
+		s.String(),
+		fmt.Sprint(s),
+		fmt.Sprintf("%s %v %+v %#v %q %x %d", s, s, s, s, s, s, s),
+		fmt.Sprintf("%v", struct{ Password Secret }{s}),
+		fmt.Sprintf("%v", []Secret{s}),
+	} {
+		if strings.Contains(out, "hunter2") || strings.Contains(out, "68756e74657232") {
+			t.Errorf("Secret printed as %q", out)
+		}
+		if !strings.Contains(out, redacted) {
+			t.Errorf("Secret printed as %q, should contain %q", out, redacted)
+		}
+	}
+
+	b, err := json.Marshal(struct{ Password Secret }{s})
+	if err != nil {
+		t.Fatal("Error encoding secret", err)
+	}
+	if string(b) != `{"Password":"[REDACTED]"}` {
+		t.Errorf("Secret encoded as %s", b)
+	}
+
+	if s.Value() != "hunter2" || !bytes.Equal(s.Bytes(), []byte("hunter2")) || s.Len() != 7 {
+		t.Errorf("Secret holds %q, should hold %q", s.Bytes(), "hunter2")
+	}
+}
+
+func TestSecretWipe(t *testing.T) {
+	value := []byte("correct horse")
+	s := NewSecret(value)
+	value[0] = 'C'
+	if s.Value() != "correct horse" {
+		t.Errorf("Secret holds %q, should hold its own copy of the value", s.Value())
+	}
+
+	b := s.Bytes()
+	c := s
+	s.Wipe()
+	if !bytes.Equal(b, make([]byte, len(b))) {
+		t.Errorf("Wiped secret memory holds %q, should be zeroed", b)
+	}
+	if s.Len() != 0 || c.Len() != 0 || c.Bytes() != nil || s.Locked() {
+		t.Error("Copy of a wiped secret should also be wiped")
+	}
+	// Wiping twice is safe
+	s.Wipe()
+
+	var zero Secret
+	zero.Wipe()
+	if zero.Len() != 0 || zero.Locked() || zero.String() != redacted {
+		t.Error("Zero Secret should be empty")
+	}
+}
+
+func TestSecretLocked(t *testing.T) {
+	s := NewSecret([]byte("hunter2"))
+	defer s.Wipe()
+	if runtime.GOOS != "linux" {
+		if s.Locked() {
+			t.Error("Secret memory should only be locked on Linux")
+		}
+		return
+	}
+	// Locking fails when the process is over its RLIMIT_MEMLOCK limit, so only check that locked memory is unlocked again
+	if !s.Locked() {
+		t.Skip("Secret memory couldn't be locked")
+	}
+
+	// Other secrets sharing the same page must stay locked after this one is wiped
+	other := NewSecret([]byte("swordfish"))
+	defer other.Wipe()
+	s.Wipe()
+	if s.Locked() {
+		t.Error("Wiped secret should be unlocked")
+	}
+	if !other.Locked() || other.Value() != "swordfish" {
+		t.Error("Wiping a secret shouldn't affect other secrets")
+	}
+}
+
+func TestSecretBytes(t *testing.T) {
+	gen, err := NewPassword(WithCharset(Digits))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	b, err := gen.GeneratePasswordBytes(8, 8)
+	if err != nil {
+		t.Fatal("Error generating password", err)
+	}
+	if len(b) != 8 || cap(b) != 8 || strings.Trim(string(b), Digits.String()) != "" {
+		t.Errorf("GeneratePasswordBytes returned %q (capacity %d), should be exactly 8 digits", b, cap(b))
+	}
+	if _, err := gen.GeneratePasswordBytes(8, 4); err == nil {
+		t.Error("GeneratePasswordBytes should fail when min is more than max")
+	}
+
+	// Characters of 1 to 4 bytes, so the encoded length varies
+	runes, err := NewRunePasswordGenerator(NewCharset("aé€😀"), NoNormalization)
+	if err != nil {
+		t.Fatal("Error creating rune password generator", err)
+	}
+	b, err = runes.GeneratePasswordBytes(10, 10)
+	if err != nil {
+		t.Fatal("Error generating password", err)
+	}
+	if !utf8.Valid(b) || utf8.RuneCount(b) != 10 || len(b) != cap(b) || strings.Trim(string(b), "aé€😀") != "" {
+		t.Errorf("GeneratePasswordBytes returned %q (capacity %d), should be exactly 10 characters", b, cap(b))
+	}
+	if _, err := runes.GeneratePasswordBytes(8, 4); err == nil {
+		t.Error("GeneratePasswordBytes should fail when min is more than max")
+	}
+
+	words := []string{"apple", "banana", "cherry"}
+	phrase, err := NewPassphrase(WithDictionaryWords(words), WithFormat(Format{Separator: "-", Digits: 2, Symbols: 1, Padding: 2}))
+	if err != nil {
+		t.Fatal("Error creating passphrase generator", err)
+	}
+	b, err = phrase.PassphraseBytes(3)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if strings.Count(string(b), "-") < 2 || len(b) != cap(b) {
+		t.Errorf("PassphraseBytes returned %q (capacity %d)", b, cap(b))
+	}
+	if _, err := phrase.PassphraseBytes(-1); err == nil {
+		t.Error("PassphraseBytes should fail for a negative number of words")
+	}
+
+	s, err := phrase.Generate(context.Background())
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if s.Len() == 0 || s.String() != redacted {
+		t.Errorf("Generate returned %q", s.Value())
+	}
+	s.Wipe()
+}
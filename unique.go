@@ -0,0 +1,175 @@
+package passgen
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Unique batches refuse to use more than this fraction of the possible secrets.
+// Past it, most secrets generated would be duplicates that have to be thrown away, and a batch could take a very long time to finish
+const maxUniqueFraction = 0.5
+
+// UniqueSet records the secrets a unique batch has generated, so none are generated twice.
+// Use WithUniqueSet to choose a set other than a MemorySet, such as a BloomSet for very large batches.
+// Sets are used by one batch at a time and don't need to be safe for concurrent use.
+// Reusing a set across batches keeps every batch unique from the ones before it
+type UniqueSet interface {
+	// Add records a secret, returning false if it has, or may have, been added before
+	Add(secret []byte) (bool, error)
+}
+
+var (
+	_ UniqueSet = (*MemorySet)(nil)
+	_ UniqueSet = (*BloomSet)(nil)
+)
+
+// MemorySet is a UniqueSet that keeps a 128 bit hash of each secret in memory, rather than the secret itself.
+// It uses about 40 bytes for each secret
+type MemorySet struct {
+	seen map[[16]byte]struct{}
+}
+
+// Create a new, empty MemorySet
+func NewMemorySet() *MemorySet {
+	return &MemorySet{seen: make(map[[16]byte]struct{})}
+}
+
+// Add records a secret, returning false if it was added before
+func (s *MemorySet) Add(secret []byte) (bool, error) {
+	key := memoryKey(secret)
+	if _, ok := s.seen[key]; ok {
+		return false, nil
+	}
+	s.seen[key] = struct{}{}
+	return true, nil
+}
+
+// Contains reports whether a secret was added to the set
+func (s *MemorySet) Contains(secret []byte) bool {
+	_, ok := s.seen[memoryKey(secret)]
+	return ok
+}
+
+// Get the key a secret is stored under in a MemorySet
+func memoryKey(secret []byte) [16]byte {
+	sum := sha256.Sum256(secret)
+	var key [16]byte
+	copy(key[:], sum[:])
+	return key
+}
+
+// Len returns the number of different secrets added to the set
+func (s *MemorySet) Len() int {
+	return len(s.seen)
+}
+
+// BloomSet is a UniqueSet for very large batches, which uses a Bloom filter to remember secrets in a fixed amount of memory -
+// about 10 bits for each secret with a 1% false positive rate.
+// A false positive makes a new secret look like a duplicate, so it is thrown away and another generated:
+// batches are still guaranteed to be unique, but slightly fewer secrets are possible
+type BloomSet struct {
+	bits []uint64
+	m    uint64
+	k    int
+	n    int
+}
+
+// Create a new, empty BloomSet sized for n secrets with the given false positive rate, such as 0.01.
+// More than n secrets can be added, but the false positive rate rises
+func NewBloomSet(n int, falsePositiveRate float64) (*BloomSet, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("%w: number of secrets must be positive", ErrInvalidLength)
+	}
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		return nil, fmt.Errorf("False positive rate must be between 0 and 1, not %v", falsePositiveRate)
+	}
+	// Optimal number of bits and hash functions for n items at the false positive rate
+	m := math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := int(math.Max(1, math.Round(m/float64(n)*math.Ln2)))
+	words := (uint64(m) + 63) / 64
+	return &BloomSet{bits: make([]uint64, words), m: words * 64, k: k}, nil
+}
+
+// Add records a secret, returning false if it may have been added before
+func (s *BloomSet) Add(secret []byte) (bool, error) {
+	added := false
+	s.each(secret, func(word int, mask uint64) bool {
+		if s.bits[word]&mask == 0 {
+			s.bits[word] |= mask
+			added = true
+		}
+		return true
+	})
+	if added {
+		s.n++
+	}
+	return added, nil
+}
+
+// Contains reports whether a secret may have been added to the set. False positives are possible, but false negatives aren't
+func (s *BloomSet) Contains(secret []byte) bool {
+	found := true
+	s.each(secret, func(word int, mask uint64) bool {
+		found = s.bits[word]&mask != 0
+		return found
+	})
+	return found
+}
+
+// Call f with each of the k bits for a secret, until it returns false
+func (s *BloomSet) each(secret []byte, f func(word int, mask uint64) bool) {
+	// Derive the k bit positions from two halves of one hash
+	sum := sha256.Sum256(secret)
+	h1 := binary.LittleEndian.Uint64(sum[0:8])
+	h2 := binary.LittleEndian.Uint64(sum[8:16]) | 1
+	for i := 0; i < s.k; i++ {
+		bit := (h1 + uint64(i)*h2) % s.m
+		if !f(int(bit/64), uint64(1)<<(bit%64)) {
+			return
+		}
+	}
+}
+
+// Len returns the number of secrets added to the set that weren't reported as duplicates
+func (s *BloomSet) Len() int {
+	return s.n
+}
+
+// Size returns the memory used by the filter, in bytes
+func (s *BloomSet) Size() int {
+	return len(s.bits) * 8
+}
+
+// CollisionBits returns the collision entropy, in bits, of the secrets created by gen: -log2 of the chance that two of them are the same.
+// It is no more than gen.Bits, and is lower when some secrets are more likely than others,
+// such as the short passwords from a generator whose lengths vary.
+// The generators in this package have a CollisionBits method; other generators are assumed to make every secret equally likely, and their Bits is used
+func CollisionBits(gen Generator) (float64, error) {
+	if c, ok := gen.(interface{ CollisionBits() (float64, error) }); ok {
+		return c.CollisionBits()
+	}
+	return gen.Bits()
+}
+
+// CollisionProbability returns the probability that a batch of n secrets contains at least one duplicate if uniqueness isn't enforced - the birthday problem.
+// bits is the collision entropy of the secrets, from CollisionBits. This is the same as their entropy only if every secret is equally likely
+func CollisionProbability(bits float64, n int) float64 {
+	if n < 2 {
+		return 0
+	}
+	pairs := float64(n) * float64(n-1) / 2
+	return -math.Expm1(-pairs / math.Exp2(bits))
+}
+
+// Check that a generator whose secrets have the given collision entropy can reasonably create n unique secrets.
+// Secrets that are equally likely have 2^bits possible values; for others, 2^bits is how many equally likely secrets would repeat as often
+func checkUniqueSpace(bits float64, n int) error {
+	// Allow for floating point error in bits, which is usually a logarithm
+	possible := math.Exp2(bits) * (1 + 1e-9)
+	if float64(n) > possible*maxUniqueFraction {
+		return fmt.Errorf("%w: %d unique secrets requested, but they would repeat as often as if only about %.0f were possible", ErrSpaceTooSmall, n, possible)
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+package passgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	mathrand "math/rand"
+	"testing"
+)
+
+func TestMemorySet(t *testing.T) {
+	s := NewMemorySet()
+	for i, test := range []struct {
+		secret string
+		added  bool
+	}{{"apple", true}, {"banana", true}, {"apple", false}, {"Apple", true}, {"", true}, {"", false}} {
+		added, err := s.Add([]byte(test.secret))
+		if err != nil {
+			t.Fatal("Error adding secret", err)
+		}
+		if added != test.added {
+			t.Errorf("Add %d (%q) returned %v, expected %v", i, test.secret, added, test.added)
+		}
+	}
+	if s.Len() != 4 {
+		t.Errorf("Set has %d secrets, expected 4", s.Len())
+	}
+	if !s.Contains([]byte("banana")) || s.Contains([]byte("cherry")) {
+		t.Error("Incorrect result from Contains")
+	}
+}
+
+func TestBloomSet(t *testing.T) {
+	const n = 10000
+	s, err := NewBloomSet(n, 0.01)
+	if err != nil {
+		t.Fatal("Error creating Bloom set", err)
+	}
+	// About 10 bits for each secret
+	if s.Size() < n*9/8 || s.Size() > n*11/8 {
+		t.Errorf("Bloom set uses %d bytes for %d secrets", s.Size(), n)
+	}
+
+	added := 0
+	for i := 0; i < n; i++ {
+		if ok, _ := s.Add([]byte(fmt.Sprint("secret", i))); ok {
+			added++
+		}
+	}
+	// Secrets that were added must always be found again
+	for i := 0; i < n; i++ {
+		if !s.Contains([]byte(fmt.Sprint("secret", i))) {
+			t.Fatalf("Bloom set didn't find secret %d", i)
+		}
+		if ok, _ := s.Add([]byte(fmt.Sprint("secret", i))); ok {
+			t.Fatalf("Bloom set added secret %d twice", i)
+		}
+	}
+	if s.Len() != added {
+		t.Errorf("Bloom set has %d secrets, expected %d", s.Len(), added)
+	}
+
+	falsePositives := 0
+	for i := 0; i < n; i++ {
+		if s.Contains([]byte(fmt.Sprint("other", i))) {
+			falsePositives++
+		}
+	}
+	if rate := float64(falsePositives) / n; rate > 0.02 {
+		t.Errorf("Bloom set false positive rate is %f, expected about 0.01", rate)
+	}
+
+	if _, err := NewBloomSet(0, 0.01); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength for an empty Bloom set, got %v", err)
+	}
+	for _, rate := range []float64{0, 1, -0.5, math.NaN()} {
+		if _, err := NewBloomSet(n, rate); err == nil {
+			t.Errorf("Expected an error for a false positive rate of %v", rate)
+		}
+	}
+}
+
+func TestCollisionProbability(t *testing.T) {
+	tests := []struct {
+		bits     float64
+		n        int
+		expected float64
+	}{
+		{math.Log2(365), 23, 0.5000},     // The birthday problem
+		{math.Log2(365), 1, 0},           // Nothing to collide with
+		{math.Log2(1e8), 1000000, 1},     // 8 digit codes: almost certain
+		{math.Log2(1e8), 1000, 0.004983}, // 8 digit codes: unlikely
+		{128, 1000000000, 0},
+	}
+	for _, test := range tests {
+		p := CollisionProbability(test.bits, test.n)
+		if math.Abs(p-test.expected) > 1e-4 {
+			t.Errorf("Incorrect collision probability for %d secrets of %.2f bits. Expected: %f\t Actual: %f", test.n, test.bits, test.expected, p)
+		}
+	}
+}
+
+func TestCollisionBits(t *testing.T) {
+	// Fixed length passwords are all equally likely
+	fixed, err := NewPassword(WithCharset(Digits), WithLength(8, 8))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if bits, err := CollisionBits(fixed); err != nil || !closeTo(bits, 8*math.Log2(10)) {
+		t.Errorf("Incorrect collision entropy for fixed length passwords: %f, %v", bits, err)
+	}
+
+	// With lengths from 1 to 10, the short codes repeat far more often than the entropy suggests
+	varied, err := NewPassword(WithCharset(Digits), WithLength(1, 10), WithEntropySource(InsecureEntropySource(mathrand.New(mathrand.NewSource(1)))))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	same := 0.0
+	for length := 1; length <= 10; length++ {
+		same += math.Pow(10, -float64(length)) / 100
+	}
+	bits, err := CollisionBits(varied)
+	if err != nil || !closeTo(bits, -math.Log2(same)) {
+		t.Errorf("Incorrect collision entropy for passwords of 1 to 10 digits. Expected: %f\t Actual: %f, %v", -math.Log2(same), bits, err)
+	}
+	entropy, _ := varied.Bits()
+	if CollisionProbability(entropy, 1000) > 0.2 || CollisionProbability(bits, 1000) < 0.99 {
+		t.Errorf("Duplicates in 1000 passwords should be almost certain")
+	}
+	secrets, err := varied.GenerateN(context.Background(), 1000)
+	if err != nil {
+		t.Fatal("Error generating passwords", err)
+	}
+	seen := make(map[string]bool)
+	duplicates := 0
+	for _, s := range secrets {
+		if seen[s.Value()] {
+			duplicates++
+		}
+		seen[s.Value()] = true
+	}
+	if duplicates == 0 {
+		t.Error("Expected duplicates in 1000 passwords of 1 to 10 digits")
+	}
+	// The unique space is sized the same way
+	if _, err := varied.GenerateN(context.Background(), 500, WithUnique()); !errors.Is(err, ErrSpaceTooSmall) {
+		t.Errorf("Expected ErrSpaceTooSmall, got %v", err)
+	}
+	if _, err := varied.GenerateN(context.Background(), 400, WithUnique()); err != nil {
+		t.Error("Error generating unique passwords", err)
+	}
+
+	// Very long passwords don't underflow
+	long, err := NewPassword(WithLength(1000, 1010))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	if bits, err := CollisionBits(long); err != nil || math.IsInf(bits, 0) || bits < 1000*math.Log2(95) {
+		t.Errorf("Incorrect collision entropy for long passwords: %f, %v", bits, err)
+	}
+
+	// Caseless words come out one way with RandomCase, and cased words two
+	phrase, err := NewPassphrase(WithDictionaryWords([]string{"apple", "りんご"}), WithWordLength(1, 10), WithWordCount(1), WithFormat(Format{Capitalization: RandomCase}))
+	if err != nil {
+		t.Fatal("Error creating passphrase generator", err)
+	}
+	// "りんご" has a 1/2 chance, "apple" and "APPLE" 1/4 each
+	if bits, err := CollisionBits(phrase); err != nil || !closeTo(bits, -math.Log2(0.25+2*0.0625)) {
+		t.Errorf("Incorrect collision entropy for a mixed passphrase: %f, %v", bits, err)
+	}
+
+	runes, err := NewRunePasswordGenerator(CharRange('α', 'ω'), NoNormalization)
+	if err != nil {
+		t.Fatal("Error creating rune password generator", err)
+	}
+	if bits, err := runes.CollisionEntropy(3, 3); err != nil || !closeTo(bits, 3*math.Log2(25)) {
+		t.Errorf("Incorrect collision entropy for rune passwords: %f, %v", bits, err)
+	}
+
+	// Generators without a CollisionBits method are assumed to be uniform
+	if bits, err := CollisionBits(constantGenerator{}); err != nil || bits != 64 {
+		t.Errorf("Expected Bits to be used for other generators, got %f, %v", bits, err)
+	}
+}
+
+func TestWithUniqueSet(t *testing.T) {
+	gen, err := NewPassword(WithCharset(Digits), WithLength(2, 2))
+	if err != nil {
+		t.Fatal("Error creating password generator", err)
+	}
+	bloom, err := NewBloomSet(100, 0.001)
+	if err != nil {
+		t.Fatal("Error creating Bloom set", err)
+	}
+	memory := NewMemorySet()
+
+	for _, set := range []UniqueSet{bloom, memory} {
+		// Reusing a set keeps each batch unique from the ones before it
+		seen := make(map[string]bool)
+		for batch := 0; batch < 2; batch++ {
+			secrets, err := gen.GenerateN(context.Background(), 20, WithUniqueSet(set))
+			if err != nil {
+				t.Fatal("Error generating unique passwords", err)
+			}
+			for _, s := range secrets {
+				if seen[s.Value()] {
+					t.Errorf("Password %q generated twice", s.Value())
+				}
+				seen[s.Value()] = true
+			}
+		}
+	}
+	if memory.Len() != 40 {
+		t.Errorf("Memory set has %d secrets, expected 40", memory.Len())
+	}
+
+	if _, err := gen.GenerateN(context.Background(), 1, WithUniqueSet(nil)); err == nil {
+		t.Error("Expected an error for a nil unique set")
+	}
+}
+
+func BenchmarkUniqueSets(b *testing.B) {
+	secret := []byte("12345678")
+	b.Run("memory", func(b *testing.B) {
+		s := NewMemorySet()
+		for i := 0; i < b.N; i++ {
+			secret[i%8]++
+			s.Add(secret)
+		}
+	})
+	b.Run("bloom", func(b *testing.B) {
+		s, _ := NewBloomSet(b.N, 0.01)
+		for i := 0; i < b.N; i++ {
+			secret[i%8]++
+			s.Add(secret)
+		}
+	})
+}
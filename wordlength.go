@@ -0,0 +1,62 @@
+package passgen
+
+import (
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// LengthUnit selects how the length of a dictionary word is counted for MinWordLength and MaxWordLength
+type LengthUnit int
+
+const (
+	// Count Unicode code points, so "straße" and "слово" are 6 and 5 characters long
+	Runes LengthUnit = iota
+	// Count user-perceived characters (extended grapheme clusters), so a letter followed by a combining accent is 1 character
+	Graphemes
+	// Count bytes of UTF-8. Only matches what people see for ASCII dictionaries
+	Bytes
+)
+
+// Get the length of a word in the given unit
+func (u LengthUnit) length(w string) int {
+	switch u {
+	case Graphemes:
+		return uniseg.GraphemeClusterCount(w)
+	case Bytes:
+		return len(w)
+	}
+	return utf8.RuneCountInString(w)
+}
+
+// Change the word length requirements of the generator, and choose words from the dictionary again to match them.
+// Returns ErrEmptyDictionary if no words in the dictionary meet the new requirements.
+// A generator created from a Diceware list can no longer be used with dice rolls if the new requirements remove any words
+func (p *PassphraseGenerator) SetWordLength(min, max int, unit LengthUnit) error {
+	if err := checkLength(min, max); err != nil {
+		return err
+	}
+	p.MinWordLength, p.MaxWordLength, p.LengthUnit = min, max, unit
+	p.filter()
+	if len(p.dict) == 0 {
+		return ErrEmptyDictionary
+	}
+	return nil
+}
+
+// Choose the words from the dictionary that meet the requirements in the Generator config
+func (p *PassphraseGenerator) filter() {
+	p.dict = keep(p.words, func(w string) bool {
+		l := p.LengthUnit.length(w)
+		return l >= p.MinWordLength && l <= p.MaxWordLength
+	})
+	p.cased = 0
+	for _, w := range p.dict {
+		if hasCase(w) {
+			p.cased++
+		}
+	}
+	if p.diceware != nil && len(p.dict) != p.diceware.Len() {
+		p.diceware = nil
+	}
+}
@@ -0,0 +1,79 @@
+package passgen
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGermanWordLengths(t *testing.T) {
+	// Each word is 5 or 6 characters but up to 8 bytes
+	gen, err := NewPassphraseGeneratorFromWords([]string{"straße", "größe", "müde", "übung", "äpfel", "frühstück"}, 5, 6)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	checkDict(t, gen, "straße", "größe", "übung", "äpfel")
+}
+
+func TestRussianWordLengths(t *testing.T) {
+	gen, err := NewPassphraseGeneratorFromWords([]string{"кот", "слово", "собака", "молоко", "достопримечательность"}, 4, 6)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	checkDict(t, gen, "слово", "собака", "молоко")
+}
+
+func TestGraphemeWordLengths(t *testing.T) {
+	// "cafe\u0301" is 5 runes but 4 graphemes, "🇩🇪" is 2 runes but 1 grapheme
+	words := []string{"caf\u00e9", "cafe\u0301", "🇩🇪", "tea"}
+	gen, err := NewPassphraseGeneratorFromWords(words, 4, 4)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	checkDict(t, gen, "caf\u00e9")
+
+	if err := gen.SetWordLength(4, 4, Graphemes); err != nil {
+		t.Fatal("Error setting word length", err)
+	}
+	checkDict(t, gen, "caf\u00e9", "cafe\u0301")
+
+	if err := gen.SetWordLength(1, 1, Graphemes); err != nil {
+		t.Fatal("Error setting word length", err)
+	}
+	checkDict(t, gen, "🇩🇪")
+
+	if err := gen.SetWordLength(4, 5, Bytes); err != nil {
+		t.Fatal("Error setting word length", err)
+	}
+	checkDict(t, gen, "caf\u00e9")
+}
+
+func TestSetWordLength(t *testing.T) {
+	gen, err := NewPassphraseGeneratorFromWords([]string{"слово", "собака"}, 5, 5)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	if err := gen.SetWordLength(6, 5, Runes); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength, got %v", err)
+	}
+	if err := gen.SetWordLength(10, 12, Runes); !errors.Is(err, ErrEmptyDictionary) {
+		t.Errorf("Expected ErrEmptyDictionary, got %v", err)
+	}
+	// Words removed by an earlier length requirement can be chosen again
+	if err := gen.SetWordLength(5, 6, Runes); err != nil {
+		t.Fatal("Error setting word length", err)
+	}
+	checkDict(t, gen, "слово", "собака")
+}
+
+func TestSetWordLengthDiceware(t *testing.T) {
+	gen, err := NewPassphrase(WithWordList("eff-large"))
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	if err := gen.SetWordLength(0, 100, Runes); err != nil || gen.DicewareList() == nil {
+		t.Error("Word lengths that keep every word should keep the Diceware list", err)
+	}
+	if err := gen.SetWordLength(5, 5, Runes); err != nil || gen.DicewareList() != nil {
+		t.Error("Word lengths that remove words should drop the Diceware list", err)
+	}
+}
@@ -0,0 +1,238 @@
+package passgen
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/gob"
+	"fmt"
+	"math"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// WordList describes one of the word lists built into the package.
+// Built-in lists can be used by passing their name or an alias to WithWordList
+type WordList struct {
+	// Name used to select the list
+	Name string
+	// Other names that also select the list
+	Aliases []string
+	// Short description of the list
+	Description string
+	// BCP 47 tag of the language the words are in
+	Language string
+	// Where the list came from
+	Source string
+	// Licence the list is distributed under
+	License string
+	// Number of words in the list, before any filtering by word length
+	Size int
+	// Number of dice rolled to choose each word for numbered Diceware lists, or 0 if the list isn't numbered
+	Dice int
+
+	// Encoded and compressed words, in roll order for Diceware lists
+	stored string
+}
+
+// The built-in word lists
+var wordLists = []WordList{
+	{
+		Name:        "2of12",
+		Language:    "en",
+		Aliases:     []string{"internal"},
+		Description: "Common English words from the 12dicts project. Used by default",
+		Source:      "http://wordlist.sourceforge.net/12dicts-readme.html",
+		License:     "Public domain",
+		Size:        41238,
+		stored:      dictStored,
+	},
+	{
+		Name:        "eff-large",
+		Language:    "en",
+		Aliases:     []string{"eff"},
+		Description: "EFF's large list of memorable words, for five dice",
+		Source:      "https://www.eff.org/files/2016/07/18/eff_large_wordlist.txt",
+		License:     "CC BY 3.0 US, Electronic Frontier Foundation",
+		Size:        7776,
+		Dice:        5,
+		stored:      effLargeStored,
+	},
+	{
+		Name:        "eff-short-2",
+		Language:    "en",
+		Aliases:     []string{"eff-short"},
+		Description: "EFF's short list of longer words with unique three letter prefixes, for four dice",
+		Source:      "https://www.eff.org/files/2016/09/08/eff_short_wordlist_2_0.txt",
+		License:     "CC BY 3.0 US, Electronic Frontier Foundation",
+		Size:        1296,
+		Dice:        4,
+		stored:      effShort2Stored,
+	},
+	{
+		Name:        "diceware",
+		Language:    "en",
+		Aliases:     []string{"diceware-original"},
+		Description: "Arnold Reinhold's original Diceware list, for five dice",
+		Source:      "https://theworld.com/~reinhold/diceware.html",
+		License:     "CC BY 3.0, Arnold G. Reinhold",
+		Size:        7776,
+		Dice:        5,
+		stored:      dicewareStored,
+	},
+	{
+		Name:        "bip39-es",
+		Aliases:     []string{"spanish"},
+		Description: "Spanish words from the BIP-39 mnemonic standard",
+		Language:    "es",
+		Source:      "https://github.com/bitcoin/bips/blob/master/bip-0039/spanish.txt",
+		License:     "MIT, Tyler Smith and contributors",
+		Size:        2048,
+		stored:      bip39SpanishStored,
+	},
+	{
+		Name:        "bip39-fr",
+		Aliases:     []string{"french"},
+		Description: "French words from the BIP-39 mnemonic standard",
+		Language:    "fr",
+		Source:      "https://github.com/bitcoin/bips/blob/master/bip-0039/french.txt",
+		License:     "MIT, Tyler Smith and contributors",
+		Size:        2048,
+		stored:      bip39FrenchStored,
+	},
+	{
+		Name:        "bip39-it",
+		Aliases:     []string{"italian"},
+		Description: "Italian words from the BIP-39 mnemonic standard",
+		Language:    "it",
+		Source:      "https://github.com/bitcoin/bips/blob/master/bip-0039/italian.txt",
+		License:     "MIT, Tyler Smith and contributors",
+		Size:        2048,
+		stored:      bip39ItalianStored,
+	},
+	{
+		Name:        "bip39-ja",
+		Aliases:     []string{"japanese"},
+		Description: "Japanese words from the BIP-39 mnemonic standard",
+		Language:    "ja",
+		Source:      "https://github.com/bitcoin/bips/blob/master/bip-0039/japanese.txt",
+		License:     "MIT, Tyler Smith and contributors",
+		Size:        2048,
+		stored:      bip39JapaneseStored,
+	},
+	{
+		Name:        "bip39-cs",
+		Aliases:     []string{"czech"},
+		Description: "Czech words from the BIP-39 mnemonic standard",
+		Language:    "cs",
+		Source:      "https://github.com/bitcoin/bips/blob/master/bip-0039/czech.txt",
+		License:     "MIT, Tyler Smith and contributors",
+		Size:        2048,
+		stored:      bip39CzechStored,
+	},
+	{
+		Name:        "bip39-ko",
+		Aliases:     []string{"korean"},
+		Description: "Korean words from the BIP-39 mnemonic standard",
+		Language:    "ko",
+		Source:      "https://github.com/bitcoin/bips/blob/master/bip-0039/korean.txt",
+		License:     "MIT, Tyler Smith and contributors",
+		Size:        2048,
+		stored:      bip39KoreanStored,
+	},
+}
+
+// WordLists returns the built-in word lists
+func WordLists() []WordList {
+	return append([]WordList{}, wordLists...)
+}
+
+// LookupWordList finds a built-in word list by its name or one of its aliases
+func LookupWordList(name string) (WordList, bool) {
+	for _, l := range wordLists {
+		if l.Name == name {
+			return l, true
+		}
+		for _, alias := range l.Aliases {
+			if alias == name {
+				return l, true
+			}
+		}
+	}
+	return WordList{}, false
+}
+
+// The list used for each language, in the order of wordLists.
+// Lists after the first in the same language are only chosen by name
+var languageLists, languageMatcher = func() ([]WordList, language.Matcher) {
+	var lists []WordList
+	var tags []language.Tag
+	seen := make(map[string]bool)
+	for _, l := range wordLists {
+		if !seen[l.Language] {
+			seen[l.Language] = true
+			lists = append(lists, l)
+			tags = append(tags, language.MustParse(l.Language))
+		}
+	}
+	return lists, language.NewMatcher(tags)
+}()
+
+// LookupLanguage finds the built-in word list that best matches a BCP 47 language tag, such as "es", "fr-CA" or "ja-JP".
+// Returns an error matching ErrUnsupportedLanguage if the tag is malformed or there is no list in its language
+func LookupLanguage(tag string) (WordList, error) {
+	t, err := language.Parse(tag)
+	if err != nil {
+		return WordList{}, fmt.Errorf("%w: %v", ErrUnsupportedLanguage, err)
+	}
+	_, i, confidence := languageMatcher.Match(t)
+	if confidence < language.High {
+		return WordList{}, fmt.Errorf("%w: %s", ErrUnsupportedLanguage, tag)
+	}
+	return languageLists[i], nil
+}
+
+// EntropyPerWord returns the entropy, in bits, that each word chosen from the whole list adds to a passphrase
+func (l WordList) EntropyPerWord() float64 {
+	return math.Log2(float64(l.Size))
+}
+
+// Decoded words of a built-in list, shared by every generator that uses it
+type decodedList struct {
+	once  sync.Once
+	words []string
+	err   error
+}
+
+// Decoded built-in lists, by name
+var decodedLists sync.Map
+
+// Get the words in the list, decoding it the first time it is used by the process.
+// The returned slice is shared, so it must not be modified
+func (l WordList) words() ([]string, error) {
+	v, _ := decodedLists.LoadOrStore(l.Name, &decodedList{})
+	d := v.(*decodedList)
+	d.once.Do(func() {
+		d.words, d.err = l.decode()
+	})
+	return d.words, d.err
+}
+
+// Decode the words in the list
+func (l WordList) decode() ([]string, error) {
+	var words []string
+	b, err := base64.StdEncoding.DecodeString(l.stored)
+	if err != nil {
+		return nil, &DictionaryError{Path: l.Name, Err: err}
+	}
+	z, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, &DictionaryError{Path: l.Name, Err: err}
+	}
+	defer z.Close()
+	if err := gob.NewDecoder(z).Decode(&words); err != nil {
+		return nil, &DictionaryError{Path: l.Name, Err: err}
+	}
+	return words, nil
+}
@@ -0,0 +1,231 @@
+package passgen
+
+import (
+	"errors"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestWordLists(t *testing.T) {
+	for _, l := range WordLists() {
+		words, err := l.words()
+		if err != nil {
+			t.Fatalf("Error decoding word list %s: %v", l.Name, err)
+		}
+		if len(words) != l.Size {
+			t.Errorf("Incorrect size for word list %s. Expected: %d\t Actual: %d", l.Name, l.Size, len(words))
+		}
+		if l.Dice > 0 && l.Size != pow6(l.Dice) {
+			t.Errorf("Word list %s does not have a word for every roll of %d dice", l.Name, l.Dice)
+		}
+		if !closeTo(l.EntropyPerWord(), math.Log2(float64(l.Size))) {
+			t.Errorf("Incorrect entropy per word for word list %s", l.Name)
+		}
+	}
+}
+
+func TestLookupWordList(t *testing.T) {
+	for _, name := range []string{"internal", "2of12", "eff", "eff-large", "eff-short-2", "diceware", "bip39-ja", "spanish"} {
+		if _, ok := LookupWordList(name); !ok {
+			t.Errorf("Word list %s not found", name)
+		}
+	}
+	if _, ok := LookupWordList("path/to/dictionary"); ok {
+		t.Error("Unexpected word list found")
+	}
+}
+
+func TestBuiltinDicewareLists(t *testing.T) {
+	tests := []struct {
+		list, roll, word string
+	}{
+		{"eff-large", "11111", "abacus"},
+		{"eff-large", "66666", "zoom"},
+		{"eff-short-2", "1111", "aardvark"},
+		{"eff-short-2", "6666", "zucchini"},
+		{"diceware", "11111", "a"},
+		{"diceware", "66666", "@"},
+	}
+	for _, test := range tests {
+		gen, err := NewPassphrase(WithWordList(test.list))
+		if err != nil {
+			t.Fatal("Error generating passphrase generator", err)
+		}
+		p, err := gen.PassphraseFromRolls([]string{test.roll})
+		if err != nil {
+			t.Fatal("Error generating passphrase", err)
+		}
+		if p != test.word {
+			t.Errorf("Incorrect word for roll %s of %s. Expected: %s\t Actual: %s", test.roll, test.list, test.word, p)
+		}
+	}
+	gen, err := NewPassphrase(WithWordList("2of12"))
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	if _, err := gen.PassphraseFromRolls([]string{"11111"}); !errors.Is(err, ErrNoDicewareList) {
+		t.Error("Expected ErrNoDicewareList for a list that isn't numbered", err)
+	}
+}
+
+func TestBuiltinPassphraseGenerator(t *testing.T) {
+	gen, err := NewPassphrase(WithWordList("eff-large"), WithWordLength(1, 100))
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	if !closeTo(gen.Entropy(1), math.Log2(7776)) {
+		t.Errorf("Incorrect entropy. Expected: %f\t Actual: %f", math.Log2(7776), gen.Entropy(1))
+	}
+}
+
+func TestDictionaryNamedLikeWordList(t *testing.T) {
+	// A dictionary file is read even if it has the name of a built-in list
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	if err := os.WriteFile("eff-large", []byte("apple\nbanana\ncherry\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	gen, err := NewPassphraseGenerator("eff-large", 1, 10)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	checkDict(t, gen, "apple", "banana", "cherry")
+
+	gen, err = NewPassphrase(WithWordList("eff-large"))
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	if len(gen.dict) != 7776 {
+		t.Errorf("Expected the built-in list, got %d words", len(gen.dict))
+	}
+}
+
+func TestLookupLanguage(t *testing.T) {
+	tests := []struct {
+		tag, list string
+	}{
+		{"en", "2of12"},
+		{"en-GB", "2of12"},
+		{"es", "bip39-es"},
+		{"es-MX", "bip39-es"},
+		{"fr-CA", "bip39-fr"},
+		{"it", "bip39-it"},
+		{"ja-JP", "bip39-ja"},
+		{"cs", "bip39-cs"},
+		{"ko-KR", "bip39-ko"},
+	}
+	for _, test := range tests {
+		l, err := LookupLanguage(test.tag)
+		if err != nil {
+			t.Fatalf("Error looking up language %s: %v", test.tag, err)
+		}
+		if l.Name != test.list {
+			t.Errorf("Incorrect word list for %s. Expected: %s\t Actual: %s", test.tag, test.list, l.Name)
+		}
+	}
+	for _, tag := range []string{"zh-CN", "ru", "not a tag"} {
+		if _, err := LookupLanguage(tag); !errors.Is(err, ErrUnsupportedLanguage) {
+			t.Errorf("Expected ErrUnsupportedLanguage for %s, got %v", tag, err)
+		}
+	}
+}
+
+func TestLocalePassphraseGenerator(t *testing.T) {
+	gen, err := NewLocalePassphraseGenerator("es-ES", 5, 5)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	// Accented words are stored composed, so "ábaco" is 5 characters long
+	if gen.dict[0] != "ábaco" {
+		t.Errorf("Incorrect first word: %q", gen.dict[0])
+	}
+
+	gen, err = NewLocalePassphraseGenerator("ja", 1, 10)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	if len(gen.dict) != 2048 {
+		t.Errorf("Expected every Japanese word to be kept, got %d", len(gen.dict))
+	}
+	p, err := gen.Passphrase(4)
+	if err != nil {
+		t.Fatal("Error generating passphrase", err)
+	}
+	if len(strings.Fields(p)) != 4 {
+		t.Errorf("Incorrect passphrase: %s", p)
+	}
+
+	if _, err := NewLocalePassphraseGenerator("zh-TW", 1, 10); !errors.Is(err, ErrUnsupportedLanguage) {
+		t.Errorf("Expected ErrUnsupportedLanguage, got %v", err)
+	}
+}
+
+func TestWordListsCached(t *testing.T) {
+	l, _ := LookupWordList("internal")
+	a, err := l.words()
+	if err != nil {
+		t.Fatal("Error decoding word list", err)
+	}
+	b, _ := l.words()
+	if &a[0] != &b[0] {
+		t.Error("Expected the decoded word list to be shared")
+	}
+
+	gen, err := NewPassphraseGenerator("internal", 5, 8)
+	if err != nil {
+		t.Fatal("Error generating passphrase generator", err)
+	}
+	gen.Clean(FoldCase(), Blocklist(a[0]))
+	if c, _ := l.words(); c[0] != a[0] || len(c) != l.Size {
+		t.Error("Cleaning a generator changed the shared word list")
+	}
+}
+
+func TestWordListsConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := NewPassphrase(WithWordList("eff-short-2"), WithWordLength(4, 8)); err != nil {
+				t.Error("Error generating passphrase generator", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Decoding the internal list every time a generator is created, as before decoded lists were cached
+func BenchmarkDecodeInternalList(b *testing.B) {
+	l, _ := LookupWordList("internal")
+	for i := 0; i < b.N; i++ {
+		if _, err := l.decode(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNewPassphraseGeneratorInternal(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := NewPassphraseGenerator("internal", 5, 8); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetXKCDPassphrase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := GetXKCDPassphrase(4); err != nil {
+			b.Fatal(err)
+		}
+	}
+}